@@ -19,7 +19,9 @@ import (
 	"k8s.io/client-go/discovery"
 	cacheddiscovery "k8s.io/client-go/discovery/cached"
 	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/informers"
 	"k8s.io/client-go/kubernetes"
+	corelisters "k8s.io/client-go/listers/core/v1"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/restmapper"
 	"k8s.io/client-go/tools/clientcmd"
@@ -27,6 +29,8 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/manager/signals"
 
 	"github.com/kubeshop/botkube/internal/analytics"
+	"github.com/kubeshop/botkube/pkg/ack"
+	"github.com/kubeshop/botkube/pkg/audit"
 	"github.com/kubeshop/botkube/pkg/bot"
 	"github.com/kubeshop/botkube/pkg/config"
 	"github.com/kubeshop/botkube/pkg/controller"
@@ -37,9 +41,10 @@ import (
 )
 
 const (
-	componentLogFieldKey = "component"
-	botLogFieldKey       = "bot"
-	printAPIKeyCharCount = 3
+	componentLogFieldKey      = "component"
+	botLogFieldKey            = "bot"
+	printAPIKeyCharCount      = 3
+	namespaceCacheSyncTimeout = 30 * time.Second
 )
 
 func main() {
@@ -110,8 +115,14 @@ func run() error {
 		return metricsSrv.Serve(ctx)
 	})
 
+	// Namespace informer backs the lookups of the Namespace annotations and labels, so they don't cost an API call per event
+	var nsLister corelisters.NamespaceLister
+	if filterengine.NamespaceListerRequired(conf) {
+		nsLister = startNamespaceInformer(ctx, logger.WithField(componentLogFieldKey, "Namespace Informer"), k8sCli, conf.Settings.InformersResyncPeriod)
+	}
+
 	// Set up the filter engine
-	filterEngine := filterengine.WithAllFilters(logger, dynamicCli, mapper, conf)
+	filterEngine := filterengine.WithAllFilters(logger, dynamicCli, k8sCli, mapper, nsLister, conf)
 
 	// List notifiers
 	notifiers, err := notifier.LoadNotifiers(logger, conf.Communications.GetFirst(), reporter)
@@ -119,6 +130,16 @@ func run() error {
 		return reportFatalError("while loading notifiers", err)
 	}
 
+	// Set up the acknowledgement tracker for critical events
+	var ackTracker *ack.Tracker
+	if ackCfg := conf.Sources.GetFirst().Acknowledgement; ackCfg.Enabled {
+		ackTracker = ack.NewTracker(logger.WithField(componentLogFieldKey, "Acknowledgement Tracker"), notifiers, ackCfg)
+		errGroup.Go(func() error {
+			defer analytics.ReportPanicIfOccurs(logger, reporter)
+			return ackTracker.Start(ctx)
+		})
+	}
+
 	// Create Executor Factory
 	resMapping, err := execute.LoadResourceMappingIfShould(
 		logger.WithField(componentLogFieldKey, "Resource Mapping Loader"),
@@ -129,13 +150,33 @@ func run() error {
 		return reportFatalError("while loading resource mapping", err)
 	}
 
+	kubectlRunner, err := execute.NewKubectlRunner(
+		logger.WithField(componentLogFieldKey, "Kubectl Runner"),
+		kubeConfig,
+		conf.Settings.Kubeconfig,
+		conf.Settings.Execution.MaxOutputSize,
+	)
+	if err != nil {
+		return reportFatalError("while creating kubectl runner", err)
+	}
+
+	// Set up the audit trail of the executed commands
+	auditSinks, err := notifier.LoadAuditSinks(conf.Settings.Audit, notifiers)
+	if err != nil {
+		return reportFatalError("while loading audit sinks", err)
+	}
+	auditor := audit.NewAuditor(logger.WithField(componentLogFieldKey, "Auditor"), auditSinks)
+
 	executorFactory := execute.NewExecutorFactory(
 		logger.WithField(componentLogFieldKey, "Executor"),
-		execute.DefaultCommandRunnerFunc,
+		kubectlRunner.Run,
+		kubectlRunner.Stream,
 		*conf,
 		filterEngine,
+		ackTracker,
 		resMapping,
 		reporter,
+		auditor,
 	)
 
 	// Run bots
@@ -211,6 +252,7 @@ func run() error {
 		filterEngine,
 		dynamicCli,
 		mapper,
+		ackTracker,
 		conf.Settings.InformersResyncPeriod,
 		reporter,
 	)
@@ -228,6 +270,23 @@ func run() error {
 	return nil
 }
 
+// startNamespaceInformer starts the Namespace informer and waits for its cache to sync, up to namespaceCacheSyncTimeout.
+// Until the cache syncs, the Namespaces are not found, so their annotations and labels are ignored.
+func startNamespaceInformer(ctx context.Context, log logrus.FieldLogger, k8sCli kubernetes.Interface, resyncPeriod time.Duration) corelisters.NamespaceLister {
+	factory := informers.NewSharedInformerFactory(k8sCli, resyncPeriod)
+	nsLister := factory.Core().V1().Namespaces().Lister()
+	factory.Start(ctx.Done())
+
+	syncCtx, cancel := context.WithTimeout(ctx, namespaceCacheSyncTimeout)
+	defer cancel()
+	for _, synced := range factory.WaitForCacheSync(syncCtx.Done()) {
+		if !synced {
+			log.Warnf("Namespace cache not synced within %s. Check if BotKube is allowed to list and watch Namespaces.", namespaceCacheSyncTimeout)
+		}
+	}
+	return nsLister
+}
+
 func newLogger(logLevelStr string, logDisableColors bool) *logrus.Logger {
 	logger := logrus.New()
 	// Output to stdout instead of the default stderr
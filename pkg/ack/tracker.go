@@ -0,0 +1,343 @@
+package ack
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/kubeshop/botkube/pkg/config"
+	"github.com/kubeshop/botkube/pkg/events"
+	"github.com/kubeshop/botkube/pkg/multierror"
+	"github.com/kubeshop/botkube/pkg/notifier"
+)
+
+const (
+	defaultTimeout     = 15 * time.Minute
+	defaultRetention   = time.Hour
+	checkInterval      = 30 * time.Second
+	httpCliTimeout     = 30 * time.Second
+	idBytesLength      = 3
+	maxIDGenerateTries = 10
+)
+
+// ErrNotFound is returned when there is no pending event with a given ID.
+var ErrNotFound = fmt.Errorf("pending event not found")
+
+// PendingEvent is a critical event waiting for the acknowledgement.
+type PendingEvent struct {
+	ID          string
+	Event       events.Event
+	CreatedAt   time.Time
+	Escalated   bool
+	EscalatedAt time.Time
+}
+
+// EscalationPayload contains JSON payload sent to the escalation webhook.
+type EscalationPayload struct {
+	ID        string       `json:"id"`
+	Cluster   string       `json:"cluster,omitempty"`
+	Kind      string       `json:"kind"`
+	Name      string       `json:"name"`
+	Namespace string       `json:"namespace,omitempty"`
+	Level     config.Level `json:"level"`
+	Reason    string       `json:"reason,omitempty"`
+	Messages  []string     `json:"messages,omitempty"`
+	CreatedAt time.Time    `json:"createdAt"`
+	Timeout   string       `json:"timeout"`
+}
+
+// Tracker tracks acknowledgements of critical events and escalates the events which weren't acknowledged in time.
+type Tracker struct {
+	log        logrus.FieldLogger
+	notifiers  []notifier.Notifier
+	timeout    time.Duration
+	retention  time.Duration
+	eventTypes map[config.EventType]struct{}
+	cfg        config.Escalation
+	httpCli    *http.Client
+
+	nowFn func() time.Time
+	idFn  func() (string, error)
+
+	mu      sync.Mutex
+	pending map[string]*PendingEvent
+}
+
+// NewTracker creates a new Tracker instance.
+func NewTracker(log logrus.FieldLogger, notifiers []notifier.Notifier, cfg config.Acknowledgement) *Tracker {
+	timeout := cfg.Timeout
+	if timeout == 0 {
+		timeout = defaultTimeout
+	}
+	retention := cfg.Escalation.Retention
+	if retention == 0 {
+		retention = defaultRetention
+	}
+	types := cfg.EventTypes
+	if len(types) == 0 {
+		types = []config.EventType{config.ErrorEvent}
+	}
+	eventTypes := map[config.EventType]struct{}{}
+	for _, t := range types {
+		eventTypes[t] = struct{}{}
+	}
+
+	return &Tracker{
+		log:        log,
+		notifiers:  notifiers,
+		timeout:    timeout,
+		retention:  retention,
+		eventTypes: eventTypes,
+		cfg:        cfg.Escalation,
+		httpCli:    &http.Client{Timeout: httpCliTimeout},
+		nowFn:      time.Now,
+		idFn:       randomID,
+		pending:    map[string]*PendingEvent{},
+	}
+}
+
+// Start periodically escalates the overdue events until the context is canceled.
+func (t *Tracker) Start(ctx context.Context) error {
+	t.log.Info("Starting acknowledgement tracker")
+	ticker := time.NewTicker(checkInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			t.log.Info("Shutdown requested. Finishing...")
+			return nil
+		case <-ticker.C:
+			if err := t.escalateOverdue(ctx); err != nil {
+				t.log.Errorf("while escalating events: %s", err.Error())
+			}
+		}
+	}
+}
+
+// Track assigns the acknowledgement ID to a given critical event of one of the configured types and starts tracking it.
+// Resolved events stop tracking the events of the same incident, as they don't need the acknowledgement anymore.
+// Events with other levels or types are ignored.
+func (t *Tracker) Track(event *events.Event) {
+	if event.Type == config.ResolvedEvent {
+		t.forgetIncident(event.IncidentKey)
+		return
+	}
+	if event.Level != config.Critical || event.AckID != "" {
+		return
+	}
+	if _, ok := t.eventTypes[event.Type]; !ok {
+		return
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	id, err := t.newID()
+	if err != nil {
+		t.log.Errorf("while generating acknowledgement ID: %s", err.Error())
+		return
+	}
+
+	event.AckID = id
+	t.pending[id] = &PendingEvent{
+		ID:        id,
+		Event:     *event,
+		CreatedAt: t.nowFn(),
+	}
+}
+
+// Ack acknowledges the event with a given ID and stops tracking it.
+func (t *Tracker) Ack(id string) (PendingEvent, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	p, found := t.pending[id]
+	if !found {
+		return PendingEvent{}, ErrNotFound
+	}
+	delete(t.pending, id)
+
+	t.log.Infof("Event %q acknowledged", id)
+	return *p, nil
+}
+
+func (t *Tracker) forgetIncident(incidentKey string) {
+	if incidentKey == "" {
+		return
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	for id, p := range t.pending {
+		if p.Event.IncidentKey != incidentKey {
+			continue
+		}
+		delete(t.pending, id)
+		t.log.Infof("Event %q resolved before the acknowledgement", id)
+	}
+}
+
+// Pending returns events waiting for the acknowledgement, sorted from the oldest one.
+func (t *Tracker) Pending() []PendingEvent {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	var out []PendingEvent
+	for _, p := range t.pending {
+		out = append(out, *p)
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].CreatedAt.Equal(out[j].CreatedAt) {
+			return out[i].ID < out[j].ID
+		}
+		return out[i].CreatedAt.Before(out[j].CreatedAt)
+	})
+	return out
+}
+
+// escalateOverdue escalates events which weren't acknowledged within the timeout.
+// Each event is escalated only once. Escalated events are dropped after the retention period.
+func (t *Tracker) escalateOverdue(ctx context.Context) error {
+	t.mu.Lock()
+	var overdue []PendingEvent
+	now := t.nowFn()
+	for id, p := range t.pending {
+		if p.Escalated {
+			if now.Sub(p.EscalatedAt) >= t.retention {
+				delete(t.pending, id)
+				t.log.Infof("Escalated event %q not acknowledged within %s. Dropping...", id, t.retention)
+			}
+			continue
+		}
+		if now.Sub(p.CreatedAt) < t.timeout {
+			continue
+		}
+		p.Escalated = true
+		p.EscalatedAt = now
+		overdue = append(overdue, *p)
+	}
+	t.mu.Unlock()
+
+	var errs error
+	for _, p := range overdue {
+		if err := t.escalate(ctx, p); err != nil {
+			errs = multierror.Append(errs, fmt.Errorf("while escalating event %q: %w", p.ID, err))
+		}
+	}
+	return errs
+}
+
+func (t *Tracker) escalate(ctx context.Context, p PendingEvent) error {
+	t.log.Infof("Event %q not acknowledged within %s. Escalating...", p.ID, t.timeout)
+
+	event := p.Event
+	event.Title = fmt.Sprintf("Escalated: %s", event.Title)
+	event.Messages = append([]string{fmt.Sprintf("Not acknowledged within %s.", t.timeout)}, event.Messages...)
+	event.Channel = ""
+	event.RoutedChannels = t.cfg.Channels
+
+	var errs error
+	for _, n := range t.notifiers {
+		if len(channelsFor(t.cfg.Channels, n.IntegrationName())) == 0 {
+			// do not re-post to the default channels
+			continue
+		}
+		if err := n.SendEvent(ctx, event); err != nil {
+			errs = multierror.Append(errs, fmt.Errorf("while sending event to %s: %w", n.IntegrationName(), err))
+		}
+	}
+
+	if t.cfg.WebhookURL != "" {
+		if err := t.postWebhook(ctx, p); err != nil {
+			errs = multierror.Append(errs, fmt.Errorf("while posting escalation webhook: %w", err))
+		}
+	}
+
+	return errs
+}
+
+func (t *Tracker) postWebhook(ctx context.Context, p PendingEvent) (err error) {
+	payload := EscalationPayload{
+		ID:        p.ID,
+		Cluster:   p.Event.Cluster,
+		Kind:      p.Event.Kind,
+		Name:      p.Event.Name,
+		Namespace: p.Event.Namespace,
+		Level:     p.Event.Level,
+		Reason:    p.Event.Reason,
+		Messages:  p.Event.Messages,
+		CreatedAt: p.CreatedAt,
+		Timeout:   t.timeout.String(),
+	}
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("while marshaling payload: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, t.cfg.WebhookURL, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("while creating request: %w", err)
+	}
+	req.Header.Add("Content-Type", "application/json")
+
+	resp, err := t.httpCli.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		deferredErr := resp.Body.Close()
+		if deferredErr != nil {
+			err = multierror.Append(err, deferredErr)
+		}
+	}()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// newID returns a unique ID. Must be called with the lock held.
+func (t *Tracker) newID() (string, error) {
+	for i := 0; i < maxIDGenerateTries; i++ {
+		id, err := t.idFn()
+		if err != nil {
+			return "", err
+		}
+		if _, exists := t.pending[id]; !exists {
+			return id, nil
+		}
+	}
+	return "", fmt.Errorf("cannot generate unique ID in %d tries", maxIDGenerateTries)
+}
+
+func randomID() (string, error) {
+	b := make([]byte, idBytesLength)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+func channelsFor(channels config.RoutingChannels, platform config.CommPlatformIntegration) []string {
+	switch platform {
+	case config.SlackCommPlatformIntegration:
+		return channels.Slack
+	case config.MattermostCommPlatformIntegration:
+		return channels.Mattermost
+	case config.DiscordCommPlatformIntegration:
+		return channels.Discord
+	}
+	return nil
+}
@@ -0,0 +1,253 @@
+package ack
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	logtest "github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kubeshop/botkube/pkg/config"
+	"github.com/kubeshop/botkube/pkg/events"
+	"github.com/kubeshop/botkube/pkg/notifier"
+)
+
+func TestTracker_TrackAndAck(t *testing.T) {
+	// given
+	now := time.Date(2022, 7, 1, 12, 0, 0, 0, time.UTC)
+	logger, _ := logtest.NewNullLogger()
+	tracker := NewTracker(logger, nil, config.Acknowledgement{})
+	tracker.nowFn = func() time.Time { return now }
+	tracker.idFn = fixSequentialIDs()
+
+	critical := fixEvent("node-1", config.Critical)
+	otherCritical := fixEvent("node-2", config.Critical)
+	warning := fixEvent("node-3", config.Warn)
+	deleted := fixEvent("node-4", config.Critical)
+	deleted.Type = config.DeleteEvent
+
+	// when
+	tracker.Track(&critical)
+	now = now.Add(time.Minute)
+	tracker.Track(&otherCritical)
+	tracker.Track(&warning)
+	tracker.Track(&deleted)
+
+	// then
+	assert.Equal(t, "id-1", critical.AckID)
+	assert.Equal(t, "id-2", otherCritical.AckID)
+	assert.Empty(t, warning.AckID, "only critical events should be tracked")
+	assert.Empty(t, deleted.AckID, "only critical error events should be tracked by default")
+
+	pending := tracker.Pending()
+	require.Len(t, pending, 2)
+	assert.Equal(t, "node-1", pending[0].Event.Name)
+	assert.Equal(t, "node-2", pending[1].Event.Name)
+
+	// when
+	acked, err := tracker.Ack("id-1")
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, "node-1", acked.Event.Name)
+	require.Len(t, tracker.Pending(), 1)
+
+	// when
+	_, err = tracker.Ack("id-1")
+
+	// then
+	assert.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestTracker_EscalateOverdue(t *testing.T) {
+	// given
+	now := time.Date(2022, 7, 1, 12, 0, 0, 0, time.UTC)
+
+	var payloads []EscalationPayload
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var payload EscalationPayload
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&payload))
+		payloads = append(payloads, payload)
+	}))
+	defer srv.Close()
+
+	slack := &fakeNotifier{platform: config.SlackCommPlatformIntegration}
+	discord := &fakeNotifier{platform: config.DiscordCommPlatformIntegration}
+
+	logger, _ := logtest.NewNullLogger()
+	tracker := NewTracker(logger, []notifier.Notifier{slack, discord}, config.Acknowledgement{
+		Timeout: 10 * time.Minute,
+		Escalation: config.Escalation{
+			Channels:   config.RoutingChannels{Slack: []string{"oncall"}},
+			WebhookURL: srv.URL,
+		},
+	})
+	tracker.nowFn = func() time.Time { return now }
+	tracker.idFn = fixSequentialIDs()
+
+	overdue := fixEvent("node-1", config.Critical)
+	tracker.Track(&overdue)
+	now = now.Add(5 * time.Minute)
+	recent := fixEvent("node-2", config.Critical)
+	tracker.Track(&recent)
+
+	// when
+	now = now.Add(6 * time.Minute)
+	err := tracker.escalateOverdue(context.Background())
+
+	// then
+	require.NoError(t, err)
+	require.Len(t, slack.sentEvents, 1)
+	assert.Empty(t, discord.sentEvents, "events shouldn't be re-posted to the default channels")
+
+	escalated := slack.sentEvents[0]
+	assert.Equal(t, "id-1", escalated.AckID)
+	assert.Equal(t, "Escalated: Node not ready", escalated.Title)
+	assert.Equal(t, []string{"Not acknowledged within 10m0s.", "Node is not ready"}, escalated.Messages)
+	assert.Equal(t, []string{"oncall"}, escalated.RoutedChannels.Slack)
+
+	require.Len(t, payloads, 1)
+	assert.Equal(t, "id-1", payloads[0].ID)
+	assert.Equal(t, "node-1", payloads[0].Name)
+	assert.Equal(t, "10m0s", payloads[0].Timeout)
+
+	pending := tracker.Pending()
+	require.Len(t, pending, 2)
+	assert.True(t, pending[0].Escalated)
+	assert.False(t, pending[1].Escalated)
+
+	// when
+	err = tracker.escalateOverdue(context.Background())
+
+	// then
+	require.NoError(t, err)
+	assert.Len(t, slack.sentEvents, 1, "events should be escalated only once")
+	assert.Len(t, payloads, 1, "events should be escalated only once")
+}
+
+func TestTracker_TrackConfiguredEventTypes(t *testing.T) {
+	// given
+	logger, _ := logtest.NewNullLogger()
+	tracker := NewTracker(logger, nil, config.Acknowledgement{
+		EventTypes: []config.EventType{config.DeleteEvent},
+	})
+	tracker.idFn = fixSequentialIDs()
+
+	errEvent := fixEvent("node-1", config.Critical)
+	deleted := fixEvent("node-2", config.Critical)
+	deleted.Type = config.DeleteEvent
+
+	// when
+	tracker.Track(&errEvent)
+	tracker.Track(&deleted)
+
+	// then
+	assert.Empty(t, errEvent.AckID)
+	assert.Equal(t, "id-1", deleted.AckID)
+	assert.Len(t, tracker.Pending(), 1)
+}
+
+func TestTracker_DropEscalatedAfterRetention(t *testing.T) {
+	// given
+	now := time.Date(2022, 7, 1, 12, 0, 0, 0, time.UTC)
+	logger, _ := logtest.NewNullLogger()
+	tracker := NewTracker(logger, nil, config.Acknowledgement{
+		Timeout: 10 * time.Minute,
+		Escalation: config.Escalation{
+			Retention: 30 * time.Minute,
+		},
+	})
+	tracker.nowFn = func() time.Time { return now }
+	tracker.idFn = fixSequentialIDs()
+
+	event := fixEvent("node-1", config.Critical)
+	tracker.Track(&event)
+
+	// when
+	now = now.Add(10 * time.Minute)
+	require.NoError(t, tracker.escalateOverdue(context.Background()))
+
+	// then
+	pending := tracker.Pending()
+	require.Len(t, pending, 1)
+	assert.True(t, pending[0].Escalated)
+	assert.Equal(t, now, pending[0].EscalatedAt)
+
+	// when
+	now = now.Add(29 * time.Minute)
+	require.NoError(t, tracker.escalateOverdue(context.Background()))
+
+	// then
+	assert.Len(t, tracker.Pending(), 1, "escalated event should be kept during the retention period")
+
+	// when
+	now = now.Add(time.Minute)
+	require.NoError(t, tracker.escalateOverdue(context.Background()))
+
+	// then
+	assert.Empty(t, tracker.Pending(), "escalated event should be dropped after the retention period")
+}
+
+func TestTracker_TrackResolved(t *testing.T) {
+	// given
+	logger, _ := logtest.NewNullLogger()
+	tracker := NewTracker(logger, nil, config.Acknowledgement{})
+
+	critical := fixEvent("node-1", config.Critical)
+	critical.IncidentKey = "node//node-1/NodeNotReady"
+	tracker.Track(&critical)
+	require.Len(t, tracker.Pending(), 1)
+
+	resolved := fixEvent("node-1", config.Info)
+	resolved.Type = config.ResolvedEvent
+	resolved.IncidentKey = critical.IncidentKey
+
+	// when
+	tracker.Track(&resolved)
+
+	// then
+	assert.Empty(t, resolved.AckID)
+	assert.Empty(t, tracker.Pending(), "resolved events shouldn't need the acknowledgement")
+}
+
+func fixEvent(name string, level config.Level) events.Event {
+	return events.Event{
+		TypeMeta: metav1.TypeMeta{Kind: "Node", APIVersion: "v1"},
+		Title:    "Node not ready",
+		Name:     name,
+		Type:     config.ErrorEvent,
+		Reason:   "NodeNotReady",
+		Messages: []string{"Node is not ready"},
+		Level:    level,
+	}
+}
+
+func fixSequentialIDs() func() (string, error) {
+	var i int
+	return func() (string, error) {
+		i++
+		return fmt.Sprintf("id-%d", i), nil
+	}
+}
+
+type fakeNotifier struct {
+	notifier.Notifier
+	platform   config.CommPlatformIntegration
+	sentEvents []events.Event
+}
+
+func (f *fakeNotifier) SendEvent(_ context.Context, event events.Event) error {
+	f.sentEvents = append(f.sentEvents, event)
+	return nil
+}
+
+func (f *fakeNotifier) IntegrationName() config.CommPlatformIntegration {
+	return f.platform
+}
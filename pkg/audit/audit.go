@@ -0,0 +1,78 @@
+package audit
+
+import (
+	"context"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/kubeshop/botkube/pkg/config"
+)
+
+const sendTimeout = 30 * time.Second
+
+// Status describes the result of the executed command.
+type Status string
+
+const (
+	// StatusSucceeded is set for the commands which were run successfully.
+	StatusSucceeded Status = "succeeded"
+	// StatusFailed is set for the commands which were run, but failed, e.g. timed out.
+	StatusFailed Status = "failed"
+	// StatusDenied is set for the commands which the user or channel is not allowed to run.
+	StatusDenied Status = "denied"
+	// StatusPendingApproval is set for the commands waiting for the confirmation or approval.
+	StatusPendingApproval Status = "pendingApproval"
+	// StatusStarted is set for the streaming sessions, e.g. `logs -f`, which run in the background.
+	StatusStarted Status = "started"
+)
+
+// Event is the audit record of a single command sent to BotKube.
+type Event struct {
+	Timestamp time.Time                      `json:"timestamp"`
+	Cluster   string                         `json:"cluster"`
+	Platform  config.CommPlatformIntegration `json:"platform"`
+	Channel   string                         `json:"channel,omitempty"`
+	User      string                         `json:"user,omitempty"`
+	// Command is the command as sent by the user. For the approved commands, it's the command which was approved.
+	Command string `json:"command"`
+	// RequestedBy is the user who requested the approved command.
+	RequestedBy string `json:"requestedBy,omitempty"`
+	Allowed     bool   `json:"allowed"`
+	Status      Status `json:"status"`
+	Error       string `json:"error,omitempty"`
+	DurationMs  int64  `json:"durationMs"`
+}
+
+// Sink stores the audit records, e.g. in a log or an external system.
+type Sink interface {
+	SendAuditEvent(ctx context.Context, event Event) error
+}
+
+// Auditor sends the audit records to the configured sinks.
+type Auditor struct {
+	log   logrus.FieldLogger
+	sinks []Sink
+}
+
+// NewAuditor creates a new Auditor instance.
+func NewAuditor(log logrus.FieldLogger, sinks []Sink) *Auditor {
+	return &Auditor{
+		log:   log,
+		sinks: sinks,
+	}
+}
+
+// AuditCommand sends a given audit record to all sinks in the background, so the command response is not delayed.
+func (a *Auditor) AuditCommand(event Event) {
+	for _, sink := range a.sinks {
+		go func(sink Sink) {
+			ctx, cancel := context.WithTimeout(context.Background(), sendTimeout)
+			defer cancel()
+
+			if err := sink.SendAuditEvent(ctx, event); err != nil {
+				a.log.Errorf("while sending audit record: %s", err.Error())
+			}
+		}(sink)
+	}
+}
@@ -0,0 +1,33 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sync"
+)
+
+// JSONSink writes the audit records as JSON lines, e.g. to the standard output.
+type JSONSink struct {
+	mu  sync.Mutex
+	enc *json.Encoder
+}
+
+// NewJSONSink creates a new JSONSink instance.
+func NewJSONSink(out io.Writer) *JSONSink {
+	return &JSONSink{
+		enc: json.NewEncoder(out),
+	}
+}
+
+// SendAuditEvent writes a given audit record in a single line.
+func (s *JSONSink) SendAuditEvent(_ context.Context, event Event) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.enc.Encode(event); err != nil {
+		return fmt.Errorf("while writing audit record: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,38 @@
+package audit
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kubeshop/botkube/pkg/config"
+)
+
+func TestJSONSink_SendAuditEvent(t *testing.T) {
+	// given
+	buf := new(bytes.Buffer)
+	sink := NewJSONSink(buf)
+	event := Event{
+		Timestamp:  time.Date(2022, 7, 1, 12, 0, 0, 0, time.UTC),
+		Cluster:    "prod",
+		Platform:   config.SlackCommPlatformIntegration,
+		Channel:    "C-OPS",
+		User:       "U-DEV",
+		Command:    "logs my-pod",
+		Allowed:    false,
+		Status:     StatusDenied,
+		Error:      "user not allowed",
+		DurationMs: 3,
+	}
+
+	// when
+	err := sink.SendAuditEvent(context.Background(), event)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, `{"timestamp":"2022-07-01T12:00:00Z","cluster":"prod","platform":"slack","channel":"C-OPS","user":"U-DEV","command":"logs my-pod","allowed":false,"status":"denied","error":"user not allowed","durationMs":3}`+"\n", buf.String())
+}
@@ -15,7 +15,7 @@ type Bot interface {
 
 // ExecutorFactory facilitates creation of execute.Executor instances.
 type ExecutorFactory interface {
-	NewDefault(platform config.CommPlatformIntegration, isAuthChannel bool, message string) execute.Executor
+	NewDefault(in execute.NewDefaultInput) execute.Executor
 }
 
 // AnalyticsReporter defines a reporter that collects analytics data.
@@ -34,3 +34,16 @@ type FatalErrorAnalyticsReporter interface {
 	// Close cleans up the reporter resources.
 	Close() error
 }
+
+// hasGroupRules returns true if the user-level authorization is enabled and any of the rules allows the user groups.
+func hasGroupRules(authz config.Authorization) bool {
+	if !authz.Enabled {
+		return false
+	}
+	for _, rule := range authz.Rules {
+		if len(rule.Groups) > 0 {
+			return true
+		}
+	}
+	return false
+}
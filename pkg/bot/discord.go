@@ -9,6 +9,15 @@ import (
 	"github.com/sirupsen/logrus"
 
 	"github.com/kubeshop/botkube/pkg/config"
+	"github.com/kubeshop/botkube/pkg/execute"
+)
+
+// buttonCommandPrefixes contains the commands which can be run with the buttons.
+var buttonCommandPrefixes = []string{"ack ", "approve ", "reject "}
+
+const (
+	// discordMaxStreamedOutput is the maximum size of the streamed output, so it fits in a single message with the code block.
+	discordMaxStreamedOutput = 1900
 )
 
 // DiscordBot listens for user's message, execute commands and sends back the response
@@ -17,13 +26,11 @@ type DiscordBot struct {
 	executorFactory ExecutorFactory
 	reporter        AnalyticsReporter
 
-	Token            string
-	AllowKubectl     bool
-	RestrictAccess   bool
-	ClusterName      string
-	ChannelID        string
-	BotID            string
-	DefaultNamespace string
+	Token       string
+	ClusterName string
+	// Channels contains the bindings of the configured channels by channel ID.
+	Channels map[string]config.BotBindings
+	BotID    string
 }
 
 // discordMessage contains message details to execute command and send back the result
@@ -34,6 +41,7 @@ type discordMessage struct {
 	BotID           string
 	Request         string
 	Response        string
+	Actions         []execute.Action
 	IsAuthChannel   bool
 	Session         *discordgo.Session
 }
@@ -41,17 +49,19 @@ type discordMessage struct {
 // NewDiscordBot returns new Bot object
 func NewDiscordBot(log logrus.FieldLogger, c *config.Config, executorFactory ExecutorFactory, reporter AnalyticsReporter) *DiscordBot {
 	discord := c.Communications.GetFirst().Discord
+	channels := map[string]config.BotBindings{}
+	for _, channel := range discord.Channels {
+		channels[channel.ID] = channel.Bindings
+	}
+
 	return &DiscordBot{
-		log:              log,
-		reporter:         reporter,
-		executorFactory:  executorFactory,
-		Token:            discord.Token,
-		BotID:            discord.BotID,
-		AllowKubectl:     c.Executors.GetFirst().Kubectl.Enabled,
-		RestrictAccess:   c.Executors.GetFirst().Kubectl.RestrictAccess,
-		ClusterName:      c.Settings.ClusterName,
-		ChannelID:        discord.Channels.GetFirst().ID,
-		DefaultNamespace: c.Executors.GetFirst().Kubectl.DefaultNamespace,
+		log:             log,
+		reporter:        reporter,
+		executorFactory: executorFactory,
+		Token:           discord.Token,
+		BotID:           discord.BotID,
+		ClusterName:     c.Settings.ClusterName,
+		Channels:        channels,
 	}
 }
 
@@ -76,6 +86,11 @@ func (b *DiscordBot) Start(ctx context.Context) error {
 		dm.HandleMessage(b)
 	})
 
+	// Register the handleInteraction func as a callback for the pressed buttons, e.g. the event acknowledgement.
+	api.AddHandler(func(s *discordgo.Session, i *discordgo.InteractionCreate) {
+		b.handleInteraction(s, i)
+	})
+
 	// Open a websocket connection to Discord and begin listening.
 	err = api.Open()
 	if err != nil {
@@ -104,6 +119,53 @@ func (b *DiscordBot) IntegrationName() config.CommPlatformIntegration {
 	return config.DiscordCommPlatformIntegration
 }
 
+// handleInteraction executes the command set as the custom ID of the pressed button and responds with the result.
+// Only the commands with buttonCommandPrefixes are supported.
+func (b *DiscordBot) handleInteraction(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	if i.Type != discordgo.InteractionMessageComponent {
+		return
+	}
+
+	request := i.MessageComponentData().CustomID
+	if !isButtonCommand(request) {
+		b.log.Debugf("Ignoring interaction with unsupported custom ID %q", request)
+		return
+	}
+
+	bindings, isAuthChannel := b.Channels[i.ChannelID]
+	user := execute.User{}
+	if i.Member != nil {
+		user = discordUser(i.Member.User, i.Member)
+	} else if i.User != nil {
+		user = discordUser(i.User, nil)
+	}
+	e := b.executorFactory.NewDefault(execute.NewDefaultInput{
+		Platform:         b.IntegrationName(),
+		IsAuthChannel:    isAuthChannel,
+		IsDirectMessage:  i.GuildID == "",
+		ExecutorBindings: bindings.Executors,
+		User:             user,
+		Message:          request,
+		OutputStreamer:   &discordMessageStreamer{session: s, channelID: i.ChannelID},
+		Channel:          i.ChannelID,
+	})
+	response := e.Execute()
+	if response == "" {
+		// the event or command is tracked by BotKube running on another cluster
+		return
+	}
+
+	err := s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseChannelMessageWithSource,
+		Data: &discordgo.InteractionResponseData{
+			Content: formatCodeBlock(response),
+		},
+	})
+	if err != nil {
+		b.log.Errorf("while responding to interaction: %s", err.Error())
+	}
+}
+
 // TODO: refactor - handle and send methods should be defined on Bot level
 
 // HandleMessage handles the incoming messages
@@ -114,9 +176,8 @@ func (dm *discordMessage) HandleMessage(b *DiscordBot) {
 	}
 
 	// Serve only if current channel is in config
-	if b.ChannelID == dm.Event.ChannelID {
-		dm.IsAuthChannel = true
-	}
+	bindings, isAuthChannel := b.Channels[dm.Event.ChannelID]
+	dm.IsAuthChannel = isAuthChannel
 
 	// Trim the @BotKube prefix
 	if strings.HasPrefix(dm.Event.Content, "<@!"+dm.BotID+"> ") {
@@ -129,12 +190,63 @@ func (dm *discordMessage) HandleMessage(b *DiscordBot) {
 		return
 	}
 
-	e := dm.executorFactory.NewDefault(b.IntegrationName(), dm.IsAuthChannel, dm.Request)
+	e := dm.executorFactory.NewDefault(execute.NewDefaultInput{
+		Platform:         b.IntegrationName(),
+		IsAuthChannel:    dm.IsAuthChannel,
+		IsDirectMessage:  dm.Event.GuildID == "",
+		ExecutorBindings: bindings.Executors,
+		User:             discordUser(dm.Event.Author, dm.Event.Member),
+		Message:          dm.Request,
+		OutputStreamer:   &discordMessageStreamer{session: dm.Session, channelID: dm.Event.ChannelID},
+		Channel:          dm.Event.ChannelID,
+	})
 
 	dm.Response = e.Execute()
+	dm.Actions = e.Actions()
 	dm.Send()
 }
 
+// isButtonCommand returns true if a given command can be run with a button.
+func isButtonCommand(command string) bool {
+	for _, prefix := range buttonCommandPrefixes {
+		if strings.HasPrefix(command, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// discordButtons returns the buttons which run the commands of given actions.
+func discordButtons(actions []execute.Action) []discordgo.MessageComponent {
+	if len(actions) == 0 {
+		return nil
+	}
+
+	var buttons []discordgo.MessageComponent
+	for _, action := range actions {
+		buttons = append(buttons, discordgo.Button{
+			Label:    action.Name,
+			Style:    discordgo.PrimaryButton,
+			CustomID: action.Command,
+		})
+	}
+	return []discordgo.MessageComponent{
+		discordgo.ActionsRow{Components: buttons},
+	}
+}
+
+// discordUser returns the identity of a given user. Roles are available only for the guild members.
+func discordUser(user *discordgo.User, member *discordgo.Member) execute.User {
+	var out execute.User
+	if user != nil {
+		out.ID = user.ID
+	}
+	if member != nil {
+		out.Groups = member.Roles
+	}
+	return out
+}
+
 func (dm discordMessage) Send() {
 	dm.log.Debugf("Discord incoming Request: %s", dm.Request)
 	dm.log.Debugf("Discord Response: %s", dm.Response)
@@ -161,7 +273,56 @@ func (dm discordMessage) Send() {
 		return
 	}
 
-	if _, err := dm.Session.ChannelMessageSend(dm.Event.ChannelID, formatCodeBlock(dm.Response)); err != nil {
+	params := &discordgo.MessageSend{
+		Content:    formatCodeBlock(dm.Response),
+		Components: discordButtons(dm.Actions),
+	}
+	if _, err := dm.Session.ChannelMessageSendComplex(dm.Event.ChannelID, params); err != nil {
 		dm.log.Error("Error in sending message:", err)
 	}
 }
+
+// discordMessageStreamer posts the output of the streaming commands, e.g. `logs -f`, in a single message,
+// which is updated with the latest output. Only the last lines which fit in the message are shown.
+type discordMessageStreamer struct {
+	session   *discordgo.Session
+	channelID string
+
+	messageID string
+	output    string
+}
+
+// StreamOutput appends a given output to the message.
+func (s *discordMessageStreamer) StreamOutput(out string) error {
+	if !strings.HasSuffix(out, "\n") {
+		out += "\n"
+	}
+	s.output = lastLines(s.output+out, discordMaxStreamedOutput)
+	content := formatCodeBlock(s.output)
+
+	if s.messageID == "" {
+		msg, err := s.session.ChannelMessageSend(s.channelID, content)
+		if err != nil {
+			return fmt.Errorf("while sending message: %w", err)
+		}
+		s.messageID = msg.ID
+		return nil
+	}
+
+	if _, err := s.session.ChannelMessageEdit(s.channelID, s.messageID, content); err != nil {
+		return fmt.Errorf("while editing message: %w", err)
+	}
+	return nil
+}
+
+// lastLines returns the last lines of a given text which fit in maxLen bytes.
+func lastLines(text string, maxLen int) string {
+	if len(text) <= maxLen {
+		return text
+	}
+	text = text[len(text)-maxLen:]
+	if idx := strings.IndexByte(text, '\n'); idx >= 0 {
+		return text[idx+1:]
+	}
+	return ""
+}
@@ -10,7 +10,9 @@ import (
 	"github.com/mattermost/mattermost-server/v5/model"
 	"github.com/sirupsen/logrus"
 
+	"github.com/kubeshop/botkube/internal/analytics"
 	"github.com/kubeshop/botkube/pkg/config"
+	"github.com/kubeshop/botkube/pkg/execute"
 )
 
 // mmChannelType to find Mattermost channel type
@@ -19,6 +21,7 @@ type mmChannelType string
 const (
 	mmChannelPrivate mmChannelType = "P"
 	mmChannelPublic  mmChannelType = "O"
+	mmChannelDirect  mmChannelType = "D"
 )
 
 const (
@@ -36,20 +39,18 @@ const (
 type MMBot struct {
 	log             logrus.FieldLogger
 	executorFactory ExecutorFactory
-	reporter        AnalyticsReporter
-
-	Token            string
-	BotName          string
-	TeamName         string
-	ChannelName      string
-	ClusterName      string
-	AllowKubectl     bool
-	RestrictAccess   bool
-	ServerURL        string
-	WebSocketURL     string
-	WSClient         *model.WebSocketClient
-	APIClient        *model.Client4
-	DefaultNamespace string
+	reporter        FatalErrorAnalyticsReporter
+
+	Token       string
+	BotName     string
+	TeamName    string
+	ClusterName string
+	// Channels contains the bindings of the configured channels by channel name.
+	Channels     map[string]config.BotBindings
+	ServerURL    string
+	WebSocketURL string
+	WSClient     *model.WebSocketClient
+	APIClient    *model.Client4
 }
 
 // mattermostMessage contains message details to execute command and send back the result
@@ -57,29 +58,32 @@ type mattermostMessage struct {
 	log             logrus.FieldLogger
 	executorFactory ExecutorFactory
 
-	Event         *model.WebSocketEvent
-	Response      string
-	Request       string
-	IsAuthChannel bool
-	APIClient     *model.Client4
+	Event            *model.WebSocketEvent
+	Response         string
+	Request          string
+	IsAuthChannel    bool
+	ExecutorBindings []string
+	APIClient        *model.Client4
 }
 
 // NewMattermostBot returns new Bot object
-func NewMattermostBot(log logrus.FieldLogger, c *config.Config, executorFactory ExecutorFactory, reporter AnalyticsReporter) *MMBot {
+func NewMattermostBot(log logrus.FieldLogger, c *config.Config, executorFactory ExecutorFactory, reporter FatalErrorAnalyticsReporter) *MMBot {
 	mattermost := c.Communications.GetFirst().Mattermost
+	channels := map[string]config.BotBindings{}
+	for _, channel := range mattermost.Channels {
+		channels[channel.Name] = channel.Bindings
+	}
+
 	return &MMBot{
-		log:              log,
-		executorFactory:  executorFactory,
-		reporter:         reporter,
-		ServerURL:        mattermost.URL,
-		BotName:          mattermost.BotName,
-		Token:            mattermost.Token,
-		TeamName:         mattermost.Team,
-		ChannelName:      mattermost.Channels.GetFirst().Name,
-		ClusterName:      c.Settings.ClusterName,
-		AllowKubectl:     c.Executors.GetFirst().Kubectl.Enabled,
-		RestrictAccess:   c.Executors.GetFirst().Kubectl.RestrictAccess,
-		DefaultNamespace: c.Executors.GetFirst().Kubectl.DefaultNamespace,
+		log:             log,
+		executorFactory: executorFactory,
+		reporter:        reporter,
+		ServerURL:       mattermost.URL,
+		BotName:         mattermost.BotName,
+		Token:           mattermost.Token,
+		TeamName:        mattermost.Team,
+		Channels:        channels,
+		ClusterName:     c.Settings.ClusterName,
 	}
 }
 
@@ -152,8 +156,12 @@ func (mm *mattermostMessage) handleMessage(b MMBot) {
 	}
 
 	// Check if message posted in authenticated channel
-	if mm.Event.Broadcast.ChannelId == b.getChannel().Id {
-		mm.IsAuthChannel = true
+	for name, bindings := range b.Channels {
+		if mm.Event.Broadcast.ChannelId == b.getChannel(name).Id {
+			mm.IsAuthChannel = true
+			mm.ExecutorBindings = bindings.Executors
+			break
+		}
 	}
 	mm.log.Debugf("Received mattermost event: %+v", mm.Event.Data)
 
@@ -161,11 +169,34 @@ func (mm *mattermostMessage) handleMessage(b MMBot) {
 	r := regexp.MustCompile(`^(?i)@BotKube `)
 	mm.Request = r.ReplaceAllString(post.Message, ``)
 
-	e := mm.executorFactory.NewDefault(b.IntegrationName(), mm.IsAuthChannel, mm.Request)
+	// Mattermost groups are not resolved, so the users are authorized only by their IDs
+	e := mm.executorFactory.NewDefault(execute.NewDefaultInput{
+		Platform:         b.IntegrationName(),
+		IsAuthChannel:    mm.IsAuthChannel,
+		IsDirectMessage:  channelType == mmChannelDirect,
+		ExecutorBindings: mm.ExecutorBindings,
+		User:             execute.User{ID: post.UserId},
+		Message:          mm.Request,
+		OutputStreamer:   mm.threadStreamer(post),
+		Channel:          mm.Event.Broadcast.ChannelId,
+	})
 	mm.Response = e.Execute()
 	mm.sendMessage()
 }
 
+// threadStreamer returns the streamer which posts the output in the thread of a given post.
+func (mm *mattermostMessage) threadStreamer(post *model.Post) *mattermostThreadStreamer {
+	rootID := post.RootId
+	if rootID == "" {
+		rootID = post.Id
+	}
+	return &mattermostThreadStreamer{
+		apiClient: mm.APIClient,
+		channelID: mm.Event.Broadcast.ChannelId,
+		rootID:    rootID,
+	}
+}
+
 // Send messages to Mattermost
 func (mm mattermostMessage) sendMessage() {
 	mm.log.Debugf("Mattermost incoming Request: %s", mm.Request)
@@ -228,11 +259,11 @@ func (b MMBot) getUser() *model.User {
 }
 
 // Create channel if not present and add BotKube user in channel
-func (b MMBot) getChannel() *model.Channel {
+func (b MMBot) getChannel(name string) *model.Channel {
 	// Checking if channel exists
-	botChannel, resp := b.APIClient.GetChannelByName(b.ChannelName, b.getTeam().Id, "")
+	botChannel, resp := b.APIClient.GetChannelByName(name, b.getTeam().Id, "")
 	if resp.Error != nil {
-		b.log.Fatalf("There was a problem finding Mattermost channel %s. %s", b.ChannelName, resp.Error)
+		b.log.Fatalf("There was a problem finding Mattermost channel %s. %s", name, resp.Error)
 	}
 
 	// Adding BotKube user to channel
@@ -281,7 +312,40 @@ func (b MMBot) listen(ctx context.Context) {
 				IsAuthChannel:   false,
 				APIClient:       b.APIClient,
 			}
-			mm.handleMessage(b)
+			// handle messages concurrently, so long-running commands can be cancelled
+			go func() {
+				defer analytics.ReportPanicIfOccurs(b.log, b.reporter)
+				mm.handleMessage(b)
+			}()
+		}
+	}
+}
+
+// mattermostThreadStreamer posts the output of the streaming commands, e.g. `logs -f`, as the thread replies.
+type mattermostThreadStreamer struct {
+	apiClient *model.Client4
+	channelID string
+	rootID    string
+}
+
+// StreamOutput posts a given output in the thread. Long output is uploaded as a file.
+func (s *mattermostThreadStreamer) StreamOutput(out string) error {
+	post := &model.Post{
+		ChannelId: s.channelID,
+		RootId:    s.rootID,
+	}
+	if len(out) >= 3990 {
+		res, resp := s.apiClient.UploadFileAsRequestBody([]byte(out), s.channelID, "output")
+		if resp.Error != nil {
+			return fmt.Errorf("while uploading file: %w", resp.Error)
 		}
+		post.FileIds = []string{res.FileInfos[0].Id}
+	} else {
+		post.Message = formatCodeBlock(out)
 	}
+
+	if _, resp := s.apiClient.CreatePost(post); resp.Error != nil {
+		return fmt.Errorf("while creating post: %w", resp.Error)
+	}
+	return nil
 }
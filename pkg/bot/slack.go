@@ -10,6 +10,7 @@ import (
 
 	"github.com/kubeshop/botkube/internal/analytics"
 	"github.com/kubeshop/botkube/pkg/config"
+	"github.com/kubeshop/botkube/pkg/execute"
 )
 
 // SlackBot listens for user's message, execute commands and sends back the response
@@ -18,14 +19,16 @@ type SlackBot struct {
 	executorFactory ExecutorFactory
 	reporter        FatalErrorAnalyticsReporter
 
-	Token            string
-	AllowKubectl     bool
-	RestrictAccess   bool
-	ClusterName      string
-	ChannelName      string
-	SlackURL         string
-	BotID            string
-	DefaultNamespace string
+	Token       string
+	ClusterName string
+	// Channels contains the bindings of the configured channels by channel name.
+	Channels map[string]config.BotBindings
+	SlackURL string
+	BotID    string
+	// ResolveUserGroups enables fetching the user groups of the message author for the user-level authorization.
+	ResolveUserGroups bool
+
+	userGroups *slackUserGroups
 }
 
 // slackMessage contains message details to execute command and send back the result
@@ -33,28 +36,33 @@ type slackMessage struct {
 	log             logrus.FieldLogger
 	executorFactory ExecutorFactory
 
-	Event         *slack.MessageEvent
-	BotID         string
-	Request       string
-	Response      string
-	IsAuthChannel bool
-	RTM           *slack.RTM
-	SlackClient   *slack.Client
+	Event            *slack.MessageEvent
+	BotID            string
+	Request          string
+	Response         string
+	IsAuthChannel    bool
+	IsDirectMessage  bool
+	ExecutorBindings []string
+	RTM              *slack.RTM
+	SlackClient      *slack.Client
 }
 
 // NewSlackBot returns new Bot object
 func NewSlackBot(log logrus.FieldLogger, c *config.Config, executorFactory ExecutorFactory, reporter FatalErrorAnalyticsReporter) *SlackBot {
 	slack := c.Communications.GetFirst().Slack
+	channels := map[string]config.BotBindings{}
+	for _, channel := range slack.Channels {
+		channels[channel.Name] = channel.Bindings
+	}
+
 	return &SlackBot{
-		log:              log,
-		executorFactory:  executorFactory,
-		reporter:         reporter,
-		Token:            slack.Token,
-		AllowKubectl:     c.Executors.GetFirst().Kubectl.Enabled,
-		RestrictAccess:   c.Executors.GetFirst().Kubectl.RestrictAccess,
-		ClusterName:      c.Settings.ClusterName,
-		ChannelName:      slack.Channels.GetFirst().Name,
-		DefaultNamespace: c.Executors.GetFirst().Kubectl.DefaultNamespace,
+		log:               log,
+		executorFactory:   executorFactory,
+		reporter:          reporter,
+		Token:             slack.Token,
+		ClusterName:       c.Settings.ClusterName,
+		Channels:          channels,
+		ResolveUserGroups: hasGroupRules(slack.Authorization),
 	}
 }
 
@@ -74,6 +82,12 @@ func (b *SlackBot) Start(ctx context.Context) error {
 		botID = authResp.UserID
 	}
 
+	if b.ResolveUserGroups {
+		b.userGroups = newSlackUserGroups(b.log, func() ([]slack.UserGroup, error) {
+			return api.GetUserGroups(slack.GetUserGroupsOptionIncludeUsers(true))
+		})
+	}
+
 	rtm := api.NewRTM()
 	go func() {
 		defer analytics.ReportPanicIfOccurs(b.log, b.reporter)
@@ -113,11 +127,15 @@ func (b *SlackBot) Start(ctx context.Context) error {
 					RTM:             rtm,
 					SlackClient:     api,
 				}
-				err := sm.HandleMessage(b)
-				if err != nil {
-					wrappedErr := fmt.Errorf("while handling message: %w", err)
-					b.log.Errorf(wrappedErr.Error())
-				}
+				// handle messages concurrently, so long-running commands can be cancelled
+				go func() {
+					defer analytics.ReportPanicIfOccurs(b.log, b.reporter)
+					err := sm.HandleMessage(b)
+					if err != nil {
+						wrappedErr := fmt.Errorf("while handling message: %w", err)
+						b.log.Errorf(wrappedErr.Error())
+					}
+				}()
 
 			case *slack.RTMError:
 				b.log.Errorf("Slack RMT error: %+v", ev.Error())
@@ -163,20 +181,26 @@ func (sm *slackMessage) HandleMessage(b *SlackBot) error {
 				return nil
 			}
 			// Serve only if current channel is in config
-			if b.ChannelName == info.Name {
-				sm.IsAuthChannel = true
-			}
+			sm.useChannelBindings(b, info.Name)
 		}
+		sm.IsDirectMessage = info.IsIM
 	}
 	// Serve only if current channel is in config
-	if b.ChannelName == sm.Event.Channel {
-		sm.IsAuthChannel = true
-	}
+	sm.useChannelBindings(b, sm.Event.Channel)
 
 	// Trim the @BotKube prefix
 	sm.Request = strings.TrimPrefix(sm.Event.Text, "<@"+sm.BotID+">")
 
-	e := sm.executorFactory.NewDefault(b.IntegrationName(), sm.IsAuthChannel, sm.Request)
+	e := sm.executorFactory.NewDefault(execute.NewDefaultInput{
+		Platform:         b.IntegrationName(),
+		IsAuthChannel:    sm.IsAuthChannel,
+		IsDirectMessage:  sm.IsDirectMessage,
+		ExecutorBindings: sm.ExecutorBindings,
+		User:             sm.user(b),
+		Message:          sm.Request,
+		OutputStreamer:   sm.threadStreamer(),
+		Channel:          sm.Event.Channel,
+	})
 	sm.Response = e.Execute()
 	err = sm.Send()
 	if err != nil {
@@ -186,6 +210,41 @@ func (sm *slackMessage) HandleMessage(b *SlackBot) error {
 	return nil
 }
 
+// user returns the identity of the message author.
+func (sm *slackMessage) user(b *SlackBot) execute.User {
+	user := execute.User{ID: sm.Event.User}
+	if b.userGroups == nil {
+		return user
+	}
+
+	// if the user groups cannot be fetched, the user is authorized only by the user ID
+	user.Groups = b.userGroups.Get(user.ID)
+	return user
+}
+
+// useChannelBindings marks the message as posted in the configured channel, if a given channel is one of them.
+func (sm *slackMessage) useChannelBindings(b *SlackBot, channelName string) {
+	bindings, found := b.Channels[channelName]
+	if !found {
+		return
+	}
+	sm.IsAuthChannel = true
+	sm.ExecutorBindings = bindings.Executors
+}
+
+// threadStreamer returns the streamer which posts the output in the thread of the message.
+func (sm *slackMessage) threadStreamer() *slackThreadStreamer {
+	threadTS := sm.Event.ThreadTimestamp
+	if threadTS == "" {
+		threadTS = sm.Event.Timestamp
+	}
+	return &slackThreadStreamer{
+		rtm:      sm.RTM,
+		channel:  sm.Event.Channel,
+		threadTS: threadTS,
+	}
+}
+
 func (sm *slackMessage) Send() error {
 	sm.log.Debugf("Slack incoming Request: %s", sm.Request)
 	sm.log.Debugf("Slack Response: %s", sm.Response)
@@ -220,3 +279,32 @@ func (sm *slackMessage) Send() error {
 
 	return nil
 }
+
+// slackThreadStreamer posts the output of the streaming commands, e.g. `logs -f`, as the thread replies.
+type slackThreadStreamer struct {
+	rtm      *slack.RTM
+	channel  string
+	threadTS string
+}
+
+// StreamOutput posts a given output in the thread. Long output is uploaded as a file.
+func (s *slackThreadStreamer) StreamOutput(out string) error {
+	if len(out) >= 3990 {
+		params := slack.FileUploadParameters{
+			Filename:        "output",
+			Content:         out,
+			Channels:        []string{s.channel},
+			ThreadTimestamp: s.threadTS,
+		}
+		if _, err := s.rtm.UploadFile(params); err != nil {
+			return fmt.Errorf("while uploading file: %w", err)
+		}
+		return nil
+	}
+
+	options := []slack.MsgOption{slack.MsgOptionText(formatCodeBlock(out), false), slack.MsgOptionAsUser(true), slack.MsgOptionTS(s.threadTS)}
+	if _, _, err := s.rtm.PostMessage(s.channel, options...); err != nil {
+		return fmt.Errorf("while posting Slack message: %w", err)
+	}
+	return nil
+}
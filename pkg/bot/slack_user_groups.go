@@ -0,0 +1,65 @@
+package bot
+
+import (
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/slack-go/slack"
+)
+
+const userGroupsCacheTTL = 5 * time.Minute
+
+// slackUserGroups caches the Slack user group membership, so the user groups are not fetched for every message.
+// If the user groups cannot be refreshed, the previously fetched membership is used until the next try.
+type slackUserGroups struct {
+	log     logrus.FieldLogger
+	ttl     time.Duration
+	fetchFn func() ([]slack.UserGroup, error)
+	nowFn   func() time.Time
+
+	mu        sync.Mutex
+	members   map[string][]string
+	expiresAt time.Time
+}
+
+func newSlackUserGroups(log logrus.FieldLogger, fetchFn func() ([]slack.UserGroup, error)) *slackUserGroups {
+	return &slackUserGroups{
+		log:     log,
+		ttl:     userGroupsCacheTTL,
+		fetchFn: fetchFn,
+		nowFn:   time.Now,
+	}
+}
+
+// Get returns IDs of the user groups a given user is a member of.
+func (g *slackUserGroups) Get(userID string) []string {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	now := g.nowFn()
+	if !now.Before(g.expiresAt) {
+		g.refresh(now)
+	}
+	return g.members[userID]
+}
+
+// refresh fetches the user groups. Must be called with the lock held.
+func (g *slackUserGroups) refresh(now time.Time) {
+	// the next try is postponed also on failure, so the Slack API is not called for every message
+	g.expiresAt = now.Add(g.ttl)
+
+	groups, err := g.fetchFn()
+	if err != nil {
+		g.log.Errorf("while getting Slack user groups: %s", err.Error())
+		return
+	}
+
+	members := map[string][]string{}
+	for _, group := range groups {
+		for _, userID := range group.Users {
+			members[userID] = append(members[userID], group.ID)
+		}
+	}
+	g.members = members
+}
@@ -0,0 +1,59 @@
+package bot
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	logtest "github.com/sirupsen/logrus/hooks/test"
+	"github.com/slack-go/slack"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSlackUserGroups_Get(t *testing.T) {
+	// given
+	now := time.Date(2022, 7, 1, 12, 0, 0, 0, time.UTC)
+	var (
+		calls    int
+		fetchErr error
+		groups   = []slack.UserGroup{
+			{ID: "S-DEVS", Users: []string{"U-DEV", "U-ADMIN"}},
+			{ID: "S-ADMINS", Users: []string{"U-ADMIN"}},
+		}
+	)
+	logger, _ := logtest.NewNullLogger()
+	userGroups := newSlackUserGroups(logger, func() ([]slack.UserGroup, error) {
+		calls++
+		return groups, fetchErr
+	})
+	userGroups.nowFn = func() time.Time { return now }
+
+	// when
+	dev := userGroups.Get("U-DEV")
+	admin := userGroups.Get("U-ADMIN")
+	other := userGroups.Get("U-OTHER")
+
+	// then
+	assert.Equal(t, []string{"S-DEVS"}, dev)
+	assert.Equal(t, []string{"S-DEVS", "S-ADMINS"}, admin)
+	assert.Empty(t, other)
+	assert.Equal(t, 1, calls, "user groups should be fetched once within the TTL")
+
+	// when
+	groups = []slack.UserGroup{{ID: "S-DEVS", Users: []string{"U-OTHER"}}}
+	now = now.Add(userGroupsCacheTTL)
+
+	// then
+	assert.Equal(t, []string{"S-DEVS"}, userGroups.Get("U-OTHER"))
+	assert.Empty(t, userGroups.Get("U-DEV"))
+	assert.Equal(t, 2, calls, "user groups should be refreshed after the TTL")
+
+	// when
+	fetchErr = errors.New("rate limited")
+	now = now.Add(userGroupsCacheTTL)
+
+	// then
+	assert.Equal(t, []string{"S-DEVS"}, userGroups.Get("U-OTHER"), "previous membership should be used on failure")
+	assert.Equal(t, []string{"S-DEVS"}, userGroups.Get("U-OTHER"))
+	assert.Equal(t, 3, calls, "failed refresh should be retried after the TTL")
+}
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
+	"sort"
 	"strings"
 
 	"github.com/gorilla/mux"
@@ -49,12 +50,10 @@ type Teams struct {
 	AppPassword      string
 	MessagePath      string
 	Port             string
-	AllowKubectl     bool
-	RestrictAccess   bool
 	ClusterName      string
 	Notification     config.Notification
 	Adapter          core.Adapter
-	DefaultNamespace string
+	ExecutorBindings []string
 
 	ConversationRef *schema.ConversationReference
 }
@@ -78,6 +77,13 @@ func NewTeamsBot(log logrus.FieldLogger, c *config.Config, executorFactory Execu
 	if msgPath == "" {
 		msgPath = "/"
 	}
+	// Teams doesn't support channels configuration, so all executors are bound to the conversations
+	var executorBindings []string
+	for name := range c.Executors {
+		executorBindings = append(executorBindings, name)
+	}
+	sort.Strings(executorBindings)
+
 	return &Teams{
 		log:              log,
 		executorFactory:  executorFactory,
@@ -88,9 +94,7 @@ func NewTeamsBot(log logrus.FieldLogger, c *config.Config, executorFactory Execu
 		Notification:     teams.Notification,
 		MessagePath:      msgPath,
 		Port:             port,
-		AllowKubectl:     c.Executors.GetFirst().Kubectl.Enabled,
-		RestrictAccess:   c.Executors.GetFirst().Kubectl.RestrictAccess,
-		DefaultNamespace: c.Executors.GetFirst().Kubectl.DefaultNamespace,
+		ExecutorBindings: executorBindings,
 		ClusterName:      c.Settings.ClusterName,
 	}
 }
@@ -210,7 +214,7 @@ func (b *Teams) processActivity(w http.ResponseWriter, req *http.Request) {
 			msgPrefix := fmt.Sprintf("<at>%s</at>", b.BotName)
 			msgWithoutPrefix := strings.TrimPrefix(consentCtx.Command, msgPrefix)
 			msg := strings.TrimSpace(msgWithoutPrefix)
-			e := b.executorFactory.NewDefault(b.IntegrationName(), true, msg)
+			e := b.executorFactory.NewDefault(b.newExecutorInput(turn.Activity, msg))
 			out := e.Execute()
 
 			actJSON, _ := json.MarshalIndent(turn.Activity, "", "  ")
@@ -250,10 +254,7 @@ func (b *Teams) processMessage(activity schema.Activity) string {
 	// User needs to execute "notifier start" cmd to enable notifications
 	// Parse "notifier" command and set conversation reference
 	args := strings.Fields(msg)
-	if activity.Conversation.ConversationType != convTypePersonal && len(args) > 0 && execute.ValidNotifierCommand[args[0]] {
-		if len(args) < 2 {
-			return execute.IncompleteCmdMsg
-		}
+	if activity.Conversation.ConversationType != convTypePersonal && len(args) > 1 && execute.ValidNotifierCommand[args[0]] {
 		if execute.Start.String() == args[1] {
 			config.Notify = true
 			ref := coreActivity.GetCoversationReference(activity)
@@ -268,10 +269,23 @@ func (b *Teams) processMessage(activity schema.Activity) string {
 	}
 
 	// Multicluster is not supported for Teams
-	e := b.executorFactory.NewDefault(b.IntegrationName(), true, msg)
+	e := b.executorFactory.NewDefault(b.newExecutorInput(activity, msg))
 	return formatCodeBlock(e.Execute())
 }
 
+// newExecutorInput returns the executor input for a given message. The users are identified by their AAD object IDs.
+func (b *Teams) newExecutorInput(activity schema.Activity, msg string) execute.NewDefaultInput {
+	return execute.NewDefaultInput{
+		Platform:         b.IntegrationName(),
+		IsAuthChannel:    true,
+		IsDirectMessage:  activity.Conversation.ConversationType == convTypePersonal,
+		ExecutorBindings: b.ExecutorBindings,
+		User:             execute.User{ID: activity.From.AadObjectID},
+		Message:          msg,
+		Channel:          activity.Conversation.ID,
+	}
+}
+
 func (b *Teams) putRequest(u string, data []byte) (err error) {
 	client := &http.Client{}
 	dec, err := url.QueryUnescape(u)
@@ -48,6 +48,8 @@ const (
 	NormalEvent EventType = "normal"
 	// InfoEvent for insignificant Info events
 	InfoEvent EventType = "info"
+	// ResolvedEvent when the object of the open incident becomes healthy
+	ResolvedEvent EventType = "resolved"
 	// AllEvent to watch all events
 	AllEvent EventType = "all"
 )
@@ -118,13 +120,23 @@ const (
 // Config structure of configuration yaml file
 type Config struct {
 	Sources        IndexableMap[Sources]        `yaml:"sources"`
-	Executors      IndexableMap[Executors]      `yaml:"executors" validate:"required,eq=1"`
+	Executors      IndexableMap[Executors]      `yaml:"executors" validate:"required,min=1"`
+	Aliases        map[string]Alias             `yaml:"aliases" validate:"dive"`
 	Communications IndexableMap[Communications] `yaml:"communications"  validate:"required,eq=1"`
 
 	Analytics Analytics `yaml:"analytics"`
 	Settings  Settings  `yaml:"settings"`
 }
 
+// Alias is a shortcut for a command, e.g. `pods` for `kubectl get pods -o wide`.
+type Alias struct {
+	// Command is run instead of the alias. It can contain the `{param}` placeholders, which are filled with the alias arguments
+	// in the order of their first appearance. Other arguments are appended to the command.
+	Command string `yaml:"command" validate:"required"`
+	// Description is shown in the list of the allowed commands.
+	Description string `yaml:"description"`
+}
+
 // ChannelBindingsByName contains configuration bindings per channel.
 type ChannelBindingsByName struct {
 	Name     string      `yaml:"name"`
@@ -152,14 +164,212 @@ type SinkBindings struct {
 type Sources struct {
 	Kubernetes      KubernetesSource `yaml:"kubernetes"`
 	Recommendations bool             `yaml:"recommendations"`
+	Filters         Filters          `yaml:"filters"`
+	Checks          Checks           `yaml:"checks"`
+	Routing         Routing          `yaml:"routing"`
+	Acknowledgement Acknowledgement  `yaml:"acknowledgement"`
+	Incidents       Incidents        `yaml:"incidents"`
+}
+
+// Incidents contains configuration for tracking the error events as open incidents.
+// Incidents are resolved when the object becomes healthy, is deleted, or reports a recovery event, e.g. `NodeReady`.
+// The health of the Pods, Nodes, Deployments, StatefulSets and DaemonSets is checked every Interval.
+type Incidents struct {
+	Enabled  bool          `yaml:"enabled"`
+	Interval time.Duration `yaml:"interval"`
+}
+
+// Acknowledgement contains configuration for the acknowledge and escalation workflow of critical events.
+// Each critical notification of one of the EventTypes gets an ID which can be acknowledged with the `ack <id>` command.
+// By default, only the error events promoted to the critical level, e.g. `NodeNotReady`, are tracked.
+// If the event is not acknowledged within the Timeout, it is escalated.
+type Acknowledgement struct {
+	Enabled    bool          `yaml:"enabled"`
+	Timeout    time.Duration `yaml:"timeout"`
+	EventTypes []EventType   `yaml:"eventTypes"`
+	Escalation Escalation    `yaml:"escalation"`
+}
+
+// Escalation contains configuration for escalating the unacknowledged events.
+// The event is re-posted to the Channels and sent to the WebhookURL, if configured.
+// Escalated events can still be acknowledged during the Retention period, after which they are dropped.
+type Escalation struct {
+	Channels   RoutingChannels `yaml:"channels"`
+	WebhookURL string          `yaml:"webhookURL"`
+	Retention  time.Duration   `yaml:"retention"`
+}
+
+// Routing contains configuration for routing events to the communication platform channels.
+// Rules are evaluated in order and the first matching rule wins. If no rule matches,
+// events are sent to the default channels. The `botkube.io/channel` annotation takes precedence over the rules.
+type Routing struct {
+	Rules []RoutingRule `yaml:"rules"`
+}
+
+// RoutingRule routes events matching all criteria to the given channels.
+type RoutingRule struct {
+	Name     string          `yaml:"name"`
+	Match    RoutingMatch    `yaml:"match"`
+	Channels RoutingChannels `yaml:"channels"`
+}
+
+// RoutingMatch contains criteria of the routing rule. Empty criteria match all events.
+// Namespaces support the `*` wildcard, e.g. `team-a-*`.
+type RoutingMatch struct {
+	Namespaces      []string          `yaml:"namespaces"`
+	NamespaceLabels map[string]string `yaml:"namespaceLabels"`
+	Kinds           []string          `yaml:"kinds"`
+	Levels          []Level           `yaml:"levels"`
+	Reasons         []string          `yaml:"reasons"`
+	Labels          map[string]string `yaml:"labels"`
+}
+
+// RoutingChannels contains channel names per communication platform. For Discord, channel IDs are used.
+type RoutingChannels struct {
+	Slack      []string `yaml:"slack"`
+	Mattermost []string `yaml:"mattermost"`
+	Discord    []string `yaml:"discord"`
+}
+
+// Checks contains configuration for periodic checks of the cluster resources.
+type Checks struct {
+	CertificateExpiry  CertificateExpiryCheck  `yaml:"certificateExpiry"`
+	HPASaturation      HPASaturationCheck      `yaml:"hpaSaturation"`
+	ResourceQuotaUsage ResourceQuotaUsageCheck `yaml:"resourceQuotaUsage"`
+}
+
+// CertificateExpiryCheck contains configuration for the TLS certificate expiry check.
+// By default, only `kubernetes.io/tls` Secrets referenced by Ingresses are checked.
+// A warning is sent once the certificate expires within each of the ThresholdDays.
+type CertificateExpiryCheck struct {
+	Enabled       bool          `yaml:"enabled"`
+	Interval      time.Duration `yaml:"interval"`
+	ThresholdDays []int         `yaml:"thresholdDays"`
+	AllTLSSecrets bool          `yaml:"allTLSSecrets"`
+}
+
+// Filters contains configuration for built-in filters.
+type Filters struct {
+	RegoPolicy           RegoPolicyFilter           `yaml:"regoPolicy"`
+	PodSecurity          PodSecurityFilter          `yaml:"podSecurity"`
+	ServiceValidator     ServiceValidatorFilter     `yaml:"serviceValidator"`
+	PodErrorEnrichment   PodErrorEnrichmentFilter   `yaml:"podErrorEnrichment"`
+	NamespaceAnnotations NamespaceAnnotationsFilter `yaml:"namespaceAnnotations"`
+}
+
+// NamespaceAnnotationsFilter contains configuration for honoring the `botkube.io/*` annotations set on Namespaces.
+// Annotations of the objects override the Namespace ones. Namespaces are cached by an informer,
+// which requires the list and watch permissions for Namespaces.
+type NamespaceAnnotationsFilter struct {
+	Enabled bool `yaml:"enabled"`
+}
+
+// RegoPolicyFilter contains configuration for the Rego policy filter.
+// Policies are loaded from all `*.rego` files in the Path directory, e.g. a mounted ConfigMap.
+// The `deny` and `warn` rules defined in the Package are converted into event warnings and recommendations.
+type RegoPolicyFilter struct {
+	Enabled bool   `yaml:"enabled"`
+	Path    string `yaml:"path"`
+	Package string `yaml:"package"`
+}
+
+// PodSecurityFilter contains configuration for the Pod security filter.
+// Namespaces limits the namespaces in which the checks are run.
+// Exemptions disable selected checks for a given namespace.
+type PodSecurityFilter struct {
+	Enabled    bool                   `yaml:"enabled"`
+	Namespaces Namespaces             `yaml:"namespaces"`
+	Exemptions []PodSecurityExemption `yaml:"exemptions"`
+}
+
+// PodSecurityExemption disables Pod security checks for a given namespace.
+// Namespace can contain a * that would expand to zero or more arbitrary characters.
+// If Checks is empty, all checks are disabled.
+type PodSecurityExemption struct {
+	Namespace string             `yaml:"namespace"`
+	Checks    []PodSecurityCheck `yaml:"checks"`
+}
+
+// PodSecurityCheck defines the Pod security check name.
+type PodSecurityCheck string
+
+const (
+	// PodSecurityCheckPrivileged checks if containers run in privileged mode.
+	PodSecurityCheckPrivileged PodSecurityCheck = "privileged"
+	// PodSecurityCheckHostNetwork checks if Pod uses the host network.
+	PodSecurityCheckHostNetwork PodSecurityCheck = "hostNetwork"
+	// PodSecurityCheckHostPID checks if Pod shares the host PID namespace.
+	PodSecurityCheckHostPID PodSecurityCheck = "hostPID"
+	// PodSecurityCheckHostPath checks if Pod mounts hostPath volumes.
+	PodSecurityCheckHostPath PodSecurityCheck = "hostPath"
+	// PodSecurityCheckRunAsRoot checks if containers can run as root user.
+	PodSecurityCheckRunAsRoot PodSecurityCheck = "runAsRoot"
+	// PodSecurityCheckCapabilities checks if containers add dangerous capabilities.
+	PodSecurityCheckCapabilities PodSecurityCheck = "capabilities"
+	// PodSecurityCheckReadOnlyRootFilesystem checks if containers have read-only root filesystem.
+	PodSecurityCheckReadOnlyRootFilesystem PodSecurityCheck = "readOnlyRootFilesystem"
+)
+
+// ServiceValidatorFilter contains configuration for the Service validator filter.
+// EndpointsGracePeriod defines how old the Service must be before its empty Endpoints are reported.
+// Services created less than EndpointsGracePeriod ago are checked again once the grace period ends.
+type ServiceValidatorFilter struct {
+	Enabled              bool          `yaml:"enabled"`
+	EndpointsGracePeriod time.Duration `yaml:"endpointsGracePeriod"`
+}
+
+// HPASaturationCheck contains configuration for the HorizontalPodAutoscaler saturation check.
+// A warning is sent if the HPA runs at maxReplicas for longer than MaxReplicasWindow,
+// or if it reports the `ScalingLimited` condition with the `TooManyReplicas` reason or the `AbleToScale=False` condition.
+type HPASaturationCheck struct {
+	Enabled           bool          `yaml:"enabled"`
+	Interval          time.Duration `yaml:"interval"`
+	MaxReplicasWindow time.Duration `yaml:"maxReplicasWindow"`
+}
+
+// ResourceQuotaUsageCheck contains configuration for the ResourceQuota usage check.
+// A warning is sent if the used amount of any resource crosses ThresholdPercent of the hard limit.
+type ResourceQuotaUsageCheck struct {
+	Enabled          bool          `yaml:"enabled"`
+	Interval         time.Duration `yaml:"interval"`
+	ThresholdPercent int           `yaml:"thresholdPercent"`
+}
+
+// PodErrorEnrichmentFilter contains configuration for the Pod error events enrichment.
+// For Pod warning events with one of the Reasons, recent logs of the failing container and the condensed
+// describe output are appended to the event. Each appended message is limited to MaxBytes.
+// Values matching the RedactPatterns regular expressions are redacted in addition to the built-in patterns.
+type PodErrorEnrichmentFilter struct {
+	Enabled        bool     `yaml:"enabled"`
+	Reasons        []string `yaml:"reasons"`
+	LogLines       int64    `yaml:"logLines"`
+	MaxBytes       int      `yaml:"maxBytes"`
+	RedactPatterns []string `yaml:"redactPatterns"`
 }
 
 // KubernetesSource contains configuration for Kubernetes sources.
 type KubernetesSource struct {
-	Resources []Resource `yaml:"resources"`
+	Resources   []Resource  `yaml:"resources"`
+	OwnerRollup OwnerRollup `yaml:"ownerRollup"`
+}
+
+// OwnerRollup contains configuration for resolving the top-level owners of the objects,
+// e.g. Deployment for Pods created by a ReplicaSet.
+type OwnerRollup struct {
+	Enabled     bool             `yaml:"enabled"`
+	CacheTTL    time.Duration    `yaml:"cacheTTL"`
+	Aggregation OwnerAggregation `yaml:"aggregation"`
+}
+
+// OwnerAggregation contains configuration for aggregating Pod events on the owner level.
+// Events with the same reason received within the Window for Pods of the same owner are sent as a single notification.
+type OwnerAggregation struct {
+	Enabled bool          `yaml:"enabled"`
+	Window  time.Duration `yaml:"window"`
 }
 
 // Executors contains executors configuration parameters.
+// Each executor is a profile which channels reference in their `bindings.executors`.
 type Executors struct {
 	Kubectl Kubectl `yaml:"kubectl"`
 }
@@ -176,6 +386,7 @@ type Resource struct {
 	Namespaces    Namespaces    `yaml:"namespaces"`
 	Events        []EventType   `yaml:"events"`
 	UpdateSetting UpdateSetting `yaml:"updateSetting"`
+	DeleteSetting DeleteSetting `yaml:"deleteSetting"`
 }
 
 //UpdateSetting struct defines updateEvent fields specification
@@ -184,6 +395,12 @@ type UpdateSetting struct {
 	IncludeDiff bool     `yaml:"includeDiff"`
 }
 
+// DeleteSetting struct defines deleteEvent fields specification
+// AttachManifest attaches the last known manifest of the deleted object to the notification
+type DeleteSetting struct {
+	AttachManifest bool `yaml:"attachManifest"`
+}
+
 // Namespaces contains namespaces to include and ignore
 // Include contains a list of namespaces to be watched,
 //  - "all" to watch all the namespaces
@@ -213,10 +430,11 @@ type Communications struct {
 
 // Slack configuration to authentication and send notifications
 type Slack struct {
-	Enabled      bool                                `yaml:"enabled"`
-	Channels     IndexableMap[ChannelBindingsByName] `yaml:"channels"  validate:"required,eq=1"`
-	Notification Notification                        `yaml:"notification,omitempty"`
-	Token        string                              `yaml:"token,omitempty"`
+	Enabled       bool                                `yaml:"enabled"`
+	Channels      IndexableMap[ChannelBindingsByName] `yaml:"channels"  validate:"required,min=1"`
+	Notification  Notification                        `yaml:"notification,omitempty"`
+	Token         string                              `yaml:"token,omitempty"`
+	Authorization Authorization                       `yaml:"authorization"`
 }
 
 // Elasticsearch config auth settings
@@ -249,13 +467,14 @@ type ELSIndex struct {
 
 // Mattermost configuration to authentication and send notifications
 type Mattermost struct {
-	Enabled      bool                                `yaml:"enabled"`
-	BotName      string                              `yaml:"botName"`
-	URL          string                              `yaml:"url"`
-	Token        string                              `yaml:"token"`
-	Team         string                              `yaml:"team"`
-	Channels     IndexableMap[ChannelBindingsByName] `yaml:"channels"  validate:"required,eq=1"`
-	Notification Notification                        `yaml:"notification,omitempty"`
+	Enabled       bool                                `yaml:"enabled"`
+	BotName       string                              `yaml:"botName"`
+	URL           string                              `yaml:"url"`
+	Token         string                              `yaml:"token"`
+	Team          string                              `yaml:"team"`
+	Channels      IndexableMap[ChannelBindingsByName] `yaml:"channels"  validate:"required,min=1"`
+	Notification  Notification                        `yaml:"notification,omitempty"`
+	Authorization Authorization                       `yaml:"authorization"`
 }
 
 // Teams creds for authentication with MS Teams
@@ -268,17 +487,19 @@ type Teams struct {
 	Port        string `yaml:"port"`
 	MessagePath string `yaml:"messagePath,omitempty"`
 	// TODO: not used yet.
-	Channels     IndexableMap[ChannelBindingsByName] `yaml:"channels"`
-	Notification Notification                        `yaml:"notification,omitempty"`
+	Channels      IndexableMap[ChannelBindingsByName] `yaml:"channels"`
+	Notification  Notification                        `yaml:"notification,omitempty"`
+	Authorization Authorization                       `yaml:"authorization"`
 }
 
 // Discord configuration for authentication and send notifications
 type Discord struct {
-	Enabled      bool                              `yaml:"enabled"`
-	Token        string                            `yaml:"token"`
-	BotID        string                            `yaml:"botID"`
-	Channels     IndexableMap[ChannelBindingsByID] `yaml:"channels"  validate:"required,eq=1"`
-	Notification Notification                      `yaml:"notification,omitempty"`
+	Enabled       bool                              `yaml:"enabled"`
+	Token         string                            `yaml:"token"`
+	BotID         string                            `yaml:"botID"`
+	Channels      IndexableMap[ChannelBindingsByID] `yaml:"channels"  validate:"required,min=1"`
+	Notification  Notification                      `yaml:"notification,omitempty"`
+	Authorization Authorization                     `yaml:"authorization"`
 }
 
 // Webhook configuration to send notifications
@@ -289,12 +510,51 @@ type Webhook struct {
 	Bindings SinkBindings
 }
 
+// Authorization contains configuration for the user-level authorization of the bot commands.
+// If enabled, a command is allowed only if one of the Rules matches its verb and the user ID or one of the user groups.
+// Commands sent in the direct messages are served only for the users allowed by any of the Rules.
+type Authorization struct {
+	Enabled       bool                `yaml:"enabled"`
+	Rules         []AuthorizationRule `yaml:"rules"`
+	Impersonation Impersonation       `yaml:"impersonation"`
+}
+
+// AuthorizationRule allows the Users and members of the Groups to run the commands with the given Verbs.
+// Verbs are the kubectl verbs, e.g. `get`, or the BotKube commands, e.g. `notifier`. The `*` verb matches all commands.
+// Users and Groups contain the platform IDs, e.g. Slack user and user group IDs, or Discord user and role IDs.
+type AuthorizationRule struct {
+	Verbs  []string `yaml:"verbs"`
+	Users  []string `yaml:"users"`
+	Groups []string `yaml:"groups"`
+}
+
+// Impersonation contains configuration for running the kubectl commands as the Kubernetes users mapped to the chat users.
+// If enabled, the commands are run with the `--as` and `--as-group` flags, so the cluster RBAC decides what each user may do.
+// Commands of the users without a mapping are refused.
+type Impersonation struct {
+	Enabled bool               `yaml:"enabled"`
+	Users   []ImpersonatedUser `yaml:"users"`
+}
+
+// ImpersonatedUser maps the chat user ID to the Kubernetes user and groups.
+type ImpersonatedUser struct {
+	ID       string   `yaml:"id"`
+	Username string   `yaml:"username"`
+	Groups   []string `yaml:"groups"`
+}
+
 // Kubectl configuration for executing commands inside cluster
+// Namespaces limits the namespaces in which the commands are allowed. All namespaces are allowed if Include is empty.
+// RestrictAccess allows the commands only from the channels which bind the executor.
 type Kubectl struct {
-	Enabled          bool     `yaml:"enabled"`
-	Commands         Commands `yaml:"commands"`
-	DefaultNamespace string   `yaml:"defaultNamespace"`
-	RestrictAccess   bool     `yaml:"restrictAccess"`
+	Enabled          bool       `yaml:"enabled"`
+	Namespaces       Namespaces `yaml:"namespaces"`
+	Commands         Commands   `yaml:"commands"`
+	DefaultNamespace string     `yaml:"defaultNamespace"`
+	RestrictAccess   bool       `yaml:"restrictAccess"`
+
+	// Policies require an additional confirmation of the matching commands before they are run.
+	Policies []CommandPolicy `yaml:"policies"`
 }
 
 // Commands allowed in bot
@@ -303,6 +563,26 @@ type Commands struct {
 	Resources []string `yaml:"resources"`
 }
 
+// CommandPolicyRequirement defines who must accept the command before it is run.
+type CommandPolicyRequirement string
+
+const (
+	// ConfirmationRequirement requires the confirmation by the user who sent the command.
+	ConfirmationRequirement CommandPolicyRequirement = "confirmation"
+	// ApprovalRequirement requires the approval by another authorized user.
+	ApprovalRequirement CommandPolicyRequirement = "approval"
+)
+
+// CommandPolicy requires the confirmation or approval of the matching kubectl commands.
+type CommandPolicy struct {
+	// Verbs matched by the policy. The `*` wildcard matches all verbs.
+	Verbs []string `yaml:"verbs"`
+	// Resources matched by the policy. If empty, the commands on all resources are matched.
+	Resources []string `yaml:"resources"`
+	// Require defines who must accept the command. Unknown values are treated as the approval.
+	Require CommandPolicyRequirement `yaml:"require"`
+}
+
 // Settings contains BotKube's related configuration.
 type Settings struct {
 	ClusterName     string `yaml:"clusterName"`
@@ -316,6 +596,49 @@ type Settings struct {
 	} `yaml:"log"`
 	InformersResyncPeriod time.Duration `yaml:"informersResyncPeriod"`
 	Kubeconfig            string        `yaml:"kubeconfig"`
+
+	Execution CommandExecution `yaml:"execution"`
+	Audit     Audit            `yaml:"audit"`
+}
+
+// Audit contains configuration of the audit records of the executed commands.
+type Audit struct {
+	// Stdout enables writing the audit records to the standard output as JSON lines.
+	Stdout bool `yaml:"stdout"`
+	// Webhook enables sending the audit records to the webhook configured in the communications.
+	Webhook bool `yaml:"webhook"`
+	// Elasticsearch configures sending the audit records to the Elasticsearch configured in the communications.
+	Elasticsearch AuditElasticsearch `yaml:"elasticsearch"`
+}
+
+// AuditElasticsearch contains configuration of the Elasticsearch audit sink.
+type AuditElasticsearch struct {
+	Enabled bool `yaml:"enabled"`
+	// Index is the name of the index for the audit records. The current date is appended to it.
+	Index string `yaml:"index"`
+}
+
+// CommandExecution contains limits of the executed kubectl commands.
+type CommandExecution struct {
+	// Timeout is the maximum duration of a single command. Zero means no timeout.
+	Timeout time.Duration `yaml:"timeout"`
+	// MaxOutputSize is the maximum size of the captured command output in bytes. Zero means no limit.
+	MaxOutputSize int `yaml:"maxOutputSize"`
+	// Streaming contains limits of the streaming sessions, e.g. `logs -f`.
+	Streaming StreamingSessions `yaml:"streaming"`
+	// ApprovalTimeout is the time after which the commands waiting for the confirmation or approval expire.
+	ApprovalTimeout time.Duration `yaml:"approvalTimeout"`
+}
+
+// StreamingSessions contains limits of the sessions which stream the output of the `--follow` and `--watch` commands.
+// At least one of MaxDuration and MaxLines needs to be set, so the sessions cannot run forever.
+type StreamingSessions struct {
+	// MaxDuration is the maximum duration of a single session. Zero means no limit.
+	MaxDuration time.Duration `yaml:"maxDuration" validate:"gte=0,required_without=MaxLines"`
+	// MaxLines is the maximum number of the streamed lines. Zero means no limit.
+	MaxLines int `yaml:"maxLines" validate:"gte=0,required_without=MaxDuration"`
+	// UpdateInterval is the interval in which the new output is posted.
+	UpdateInterval time.Duration `yaml:"updateInterval" validate:"required,gt=0"`
 }
 
 func (eventType EventType) String() string {
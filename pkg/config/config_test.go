@@ -162,7 +162,7 @@ func TestLoadedConfigValidation(t *testing.T) {
 			name: "empty executors and communications settings",
 			expErrMsg: heredoc.Doc(`
 				while validating loaded configuration: 2 errors occurred:
-					* Key: 'Config.Executors' Error:Field validation for 'Executors' failed on the 'eq' tag
+					* Key: 'Config.Executors' Error:Field validation for 'Executors' failed on the 'min' tag
 					* Key: 'Config.Communications' Error:Field validation for 'Communications' failed on the 'eq' tag`),
 			configFiles: []string{
 				testdataFile(t, "empty-executors-communications.yaml"),
@@ -170,15 +170,44 @@ func TestLoadedConfigValidation(t *testing.T) {
 		},
 		{
 			// TODO(remove): https://github.com/kubeshop/botkube/issues/596
-			name: "multiple executors and communications settings",
+			name: "multiple communications settings",
 			expErrMsg: heredoc.Doc(`
-				while validating loaded configuration: 2 errors occurred:
-					* Key: 'Config.Executors' Error:Field validation for 'Executors' failed on the 'eq' tag
+				while validating loaded configuration: 1 error occurred:
 					* Key: 'Config.Communications' Error:Field validation for 'Communications' failed on the 'eq' tag`),
 			configFiles: []string{
 				testdataFile(t, "multiple-executors-communications.yaml"),
 			},
 		},
+		{
+			name: "alias without command",
+			expErrMsg: heredoc.Doc(`
+				while validating loaded configuration: 1 error occurred:
+					* Key: 'Config.Aliases[pods].Command' Error:Field validation for 'Command' failed on the 'required' tag`),
+			configFiles: []string{
+				testdataFile(t, "alias-without-command.yaml"),
+			},
+		},
+		{
+			name: "unlimited streaming sessions",
+			expErrMsg: heredoc.Doc(`
+				while validating loaded configuration: 2 errors occurred:
+					* Key: 'Config.Settings.Execution.Streaming.MaxDuration' Error:Field validation for 'MaxDuration' failed on the 'required_without' tag
+					* Key: 'Config.Settings.Execution.Streaming.MaxLines' Error:Field validation for 'MaxLines' failed on the 'required_without' tag`),
+			configFiles: []string{
+				testdataFile(t, "unlimited-streaming.yaml"),
+			},
+		},
+		{
+			name: "negative streaming session limits",
+			expErrMsg: heredoc.Doc(`
+				while validating loaded configuration: 3 errors occurred:
+					* Key: 'Config.Settings.Execution.Streaming.MaxDuration' Error:Field validation for 'MaxDuration' failed on the 'gte' tag
+					* Key: 'Config.Settings.Execution.Streaming.MaxLines' Error:Field validation for 'MaxLines' failed on the 'gte' tag
+					* Key: 'Config.Settings.Execution.Streaming.UpdateInterval' Error:Field validation for 'UpdateInterval' failed on the 'gt' tag`),
+			configFiles: []string{
+				testdataFile(t, "negative-streaming-limits.yaml"),
+			},
+		},
 	}
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
@@ -0,0 +1,319 @@
+package controller
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	coreV1 "k8s.io/api/core/v1"
+	networkingV1 "k8s.io/api/networking/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+
+	"github.com/kubeshop/botkube/pkg/config"
+	"github.com/kubeshop/botkube/pkg/events"
+	"github.com/kubeshop/botkube/pkg/utils"
+)
+
+const (
+	defaultCertExpiryCheckInterval = 12 * time.Hour
+	secretsResource                = "v1/secrets"
+)
+
+var (
+	defaultCertExpiryThresholdDays = []int{30, 7, 1}
+
+	secretGVR = schema.GroupVersionResource{
+		Version:  "v1",
+		Resource: "secrets",
+	}
+	ingressGVR = schema.GroupVersionResource{
+		Group:    "networking.k8s.io",
+		Version:  "v1",
+		Resource: "ingresses",
+	}
+)
+
+// tlsSecretRef describes the TLS Secret together with hosts of the Ingresses which use it.
+type tlsSecretRef struct {
+	Namespace string
+	Name      string
+	// Hosts maps the host name to the Ingress name.
+	Hosts map[string]string
+}
+
+// certificateState stores the issues already reported for the certificate stored in a given Secret.
+type certificateState struct {
+	serialNumber string
+	reported     map[string]struct{}
+}
+
+// CertificateExpiryChecker periodically checks TLS certificates stored in Secrets
+// and sends events if they expire soon, are already expired, or don't match the Ingress hosts.
+type CertificateExpiryChecker struct {
+	log         logrus.FieldLogger
+	dynamicCli  dynamic.Interface
+	cfg         config.CertificateExpiryCheck
+	clusterName string
+	sendFn      sendCheckEventFn
+	nowFn       func() time.Time
+
+	// certificates stores the state of the certificates per Secret.
+	certificates map[string]*certificateState
+}
+
+// NewCertificateExpiryChecker creates a new instance of the Certificate Expiry Checker.
+func NewCertificateExpiryChecker(log logrus.FieldLogger, dynamicCli dynamic.Interface, clusterName string, cfg config.CertificateExpiryCheck, sendFn sendCheckEventFn) *CertificateExpiryChecker {
+	if cfg.Interval == 0 {
+		cfg.Interval = defaultCertExpiryCheckInterval
+	}
+	if len(cfg.ThresholdDays) == 0 {
+		cfg.ThresholdDays = defaultCertExpiryThresholdDays
+	}
+
+	return &CertificateExpiryChecker{
+		log:          log,
+		dynamicCli:   dynamicCli,
+		cfg:          cfg,
+		clusterName:  clusterName,
+		sendFn:       sendFn,
+		nowFn:        time.Now,
+		certificates: map[string]*certificateState{},
+	}
+}
+
+// Run runs the Certificate Expiry Checker and checks TLS certificates periodically.
+func (c *CertificateExpiryChecker) Run(ctx context.Context) error {
+	c.log.Info("Starting checker")
+	// Check at startup
+	if err := c.check(ctx); err != nil {
+		c.log.Errorf("while checking TLS certificates: %s", err.Error())
+	}
+
+	ticker := time.NewTicker(c.cfg.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			c.log.Info("Shutdown requested. Finishing...")
+			return nil
+		case <-ticker.C:
+			if err := c.check(ctx); err != nil {
+				c.log.Errorf("while checking TLS certificates: %s", err.Error())
+			}
+		}
+	}
+}
+
+func (c *CertificateExpiryChecker) check(ctx context.Context) error {
+	refs, err := c.tlsSecretRefs(ctx)
+	if err != nil {
+		return err
+	}
+
+	// certificates of the Secrets which don't exist or aren't used anymore are forgotten
+	certificates := map[string]*certificateState{}
+	for _, ref := range refs {
+		secretKey := fmt.Sprintf("%s/%s", ref.Namespace, ref.Name)
+
+		unstrSecret, secret, err := c.getTLSSecret(ctx, ref)
+		if err != nil {
+			c.log.Errorf("while checking TLS certificate: %s", err.Error())
+			if state, found := c.certificates[secretKey]; found {
+				certificates[secretKey] = state
+			}
+			continue
+		}
+		if secret == nil {
+			// missing Secrets are reported by the Ingress Validator filter
+			continue
+		}
+
+		cert, err := parseCertificate(secret.Data[coreV1.TLSCertKey])
+		if err != nil {
+			c.log.Warnf("Skipping Secret %s: while parsing TLS certificate: %s", secretKey, err.Error())
+			continue
+		}
+
+		state, found := c.certificates[secretKey]
+		if !found || state.serialNumber != cert.SerialNumber.String() {
+			// renewed certificates are reported from scratch
+			state = &certificateState{serialNumber: cert.SerialNumber.String(), reported: map[string]struct{}{}}
+		}
+		certificates[secretKey] = state
+
+		sendPendingEvents(ctx, c.sendFn, c.eventsForCertificate(ref, unstrSecret, cert, state.reported), state.reported)
+	}
+	c.certificates = certificates
+
+	return nil
+}
+
+// eventsForCertificate returns events about the issues of a given certificate which weren't reported yet.
+func (c *CertificateExpiryChecker) eventsForCertificate(ref tlsSecretRef, obj *unstructured.Unstructured, cert *x509.Certificate, reported map[string]struct{}) []pendingEvent {
+	var out []pendingEvent
+	secretName := fmt.Sprintf("%s/%s", ref.Namespace, ref.Name)
+	report := func(key string, eventType config.EventType, msg string) {
+		if _, found := reported[key]; found {
+			return
+		}
+		out = append(out, pendingEvent{key: key, obj: obj, event: c.newEvent(ref, eventType, msg)})
+	}
+
+	now := c.nowFn()
+	if !now.Before(cert.NotAfter) {
+		report("expired", config.ErrorEvent, fmt.Sprintf(
+			"TLS certificate in Secret '%s' expired on %s.", secretName, cert.NotAfter.UTC().Format(time.RFC1123),
+		))
+	} else if threshold, crossed := c.crossedThreshold(cert.NotAfter.Sub(now)); crossed {
+		report(fmt.Sprintf("expires/%d", threshold), config.WarningEvent, fmt.Sprintf(
+			"TLS certificate in Secret '%s' expires in less than %d day(s), on %s.", secretName, threshold, cert.NotAfter.UTC().Format(time.RFC1123),
+		))
+	}
+
+	hosts := make([]string, 0, len(ref.Hosts))
+	for host := range ref.Hosts {
+		hosts = append(hosts, host)
+	}
+	sort.Strings(hosts)
+	for _, host := range hosts {
+		if cert.VerifyHostname(host) == nil {
+			continue
+		}
+		report("host/"+host, config.ErrorEvent, fmt.Sprintf(
+			"TLS certificate in Secret '%s' is not valid for host '%s' used by Ingress '%s'.", secretName, host, ref.Hosts[host],
+		))
+	}
+
+	return out
+}
+
+// crossedThreshold returns the lowest threshold in days crossed by the time left to certificate expiration.
+func (c *CertificateExpiryChecker) crossedThreshold(timeLeft time.Duration) (int, bool) {
+	lowest, crossed := 0, false
+	for _, days := range c.cfg.ThresholdDays {
+		if timeLeft > time.Duration(days)*24*time.Hour {
+			continue
+		}
+		if !crossed || days < lowest {
+			lowest, crossed = days, true
+		}
+	}
+	return lowest, crossed
+}
+
+func (c *CertificateExpiryChecker) newEvent(ref tlsSecretRef, eventType config.EventType, msg string) events.Event {
+	return events.Event{
+		TypeMeta: metaV1.TypeMeta{
+			Kind:       "Secret",
+			APIVersion: "v1",
+		},
+		Title:     fmt.Sprintf("%s %s", secretsResource, eventType.String()),
+		Name:      ref.Name,
+		Namespace: ref.Namespace,
+		Messages:  []string{msg},
+		Type:      eventType,
+		Reason:    "CertificateExpiry",
+		Level:     events.LevelMap[eventType],
+		Cluster:   c.clusterName,
+		TimeStamp: c.nowFn(),
+		Resource:  secretsResource,
+	}
+}
+
+// tlsSecretRefs returns TLS Secrets referenced by Ingresses, and all TLS Secrets if configured.
+func (c *CertificateExpiryChecker) tlsSecretRefs(ctx context.Context) ([]tlsSecretRef, error) {
+	refs := map[string]*tlsSecretRef{}
+	getRef := func(namespace, name string) *tlsSecretRef {
+		key := fmt.Sprintf("%s/%s", namespace, name)
+		if _, ok := refs[key]; !ok {
+			refs[key] = &tlsSecretRef{Namespace: namespace, Name: name, Hosts: map[string]string{}}
+		}
+		return refs[key]
+	}
+
+	ingList, err := c.dynamicCli.Resource(ingressGVR).List(ctx, metaV1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("while listing Ingresses: %w", err)
+	}
+	for i := range ingList.Items {
+		var ing networkingV1.Ingress
+		if err := utils.TransformIntoTypedObject(&ingList.Items[i], &ing); err != nil {
+			return nil, fmt.Errorf("while transforming object type %T into type: %T: %w", ingList.Items[i], ing, err)
+		}
+		for _, tls := range ing.Spec.TLS {
+			if tls.SecretName == "" {
+				// default certificate of the Ingress controller is used
+				continue
+			}
+			ref := getRef(ing.Namespace, tls.SecretName)
+			for _, host := range tls.Hosts {
+				ref.Hosts[host] = ing.Name
+			}
+		}
+	}
+
+	if c.cfg.AllTLSSecrets {
+		secretList, err := c.dynamicCli.Resource(secretGVR).List(ctx, metaV1.ListOptions{
+			FieldSelector: fmt.Sprintf("type=%s", coreV1.SecretTypeTLS),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("while listing TLS Secrets: %w", err)
+		}
+		for _, item := range secretList.Items {
+			getRef(item.GetNamespace(), item.GetName())
+		}
+	}
+
+	out := make([]tlsSecretRef, 0, len(refs))
+	for _, ref := range refs {
+		out = append(out, *ref)
+	}
+	sort.Slice(out, func(i, j int) bool {
+		return out[i].Namespace+"/"+out[i].Name < out[j].Namespace+"/"+out[j].Name
+	})
+	return out, nil
+}
+
+// getTLSSecret returns the Secret of `kubernetes.io/tls` type, or nil if it doesn't exist.
+func (c *CertificateExpiryChecker) getTLSSecret(ctx context.Context, ref tlsSecretRef) (*unstructured.Unstructured, *coreV1.Secret, error) {
+	unstrSecret, err := c.dynamicCli.Resource(secretGVR).Namespace(ref.Namespace).Get(ctx, ref.Name, metaV1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return nil, nil, nil
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("while getting Secret %s/%s: %w", ref.Namespace, ref.Name, err)
+	}
+
+	var secret coreV1.Secret
+	if err := utils.TransformIntoTypedObject(unstrSecret, &secret); err != nil {
+		return nil, nil, fmt.Errorf("while transforming object type %T into type: %T: %w", unstrSecret, secret, err)
+	}
+	if secret.Type != coreV1.SecretTypeTLS {
+		return nil, nil, nil
+	}
+	return unstrSecret, &secret, nil
+}
+
+// parseCertificate returns the first certificate from the PEM encoded chain.
+func parseCertificate(data []byte) (*x509.Certificate, error) {
+	if len(strings.TrimSpace(string(data))) == 0 {
+		return nil, errors.New("certificate is empty")
+	}
+
+	block, _ := pem.Decode(data)
+	if block == nil || block.Type != "CERTIFICATE" {
+		return nil, errors.New("certificate is not PEM encoded")
+	}
+	return x509.ParseCertificate(block.Bytes)
+}
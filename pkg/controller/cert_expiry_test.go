@@ -0,0 +1,168 @@
+package controller
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	logtest "github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	coreV1 "k8s.io/api/core/v1"
+	networkingV1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/dynamic/fake"
+	k8stesting "k8s.io/client-go/testing"
+
+	"github.com/kubeshop/botkube/pkg/config"
+	"github.com/kubeshop/botkube/pkg/events"
+)
+
+func TestCertificateExpiryChecker(t *testing.T) {
+	// given
+	now := time.Date(2022, 7, 1, 12, 0, 0, 0, time.UTC)
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, coreV1.AddToScheme(scheme))
+	require.NoError(t, networkingV1.AddToScheme(scheme))
+	dynamicCli := fake.NewSimpleDynamicClient(scheme,
+		fixTLSSecret(t, "expiring", "example.com", now.Add(20*24*time.Hour)),
+		fixTLSSecret(t, "expired", "expired.example.com", now.Add(-time.Hour)),
+		fixTLSIngress("web", map[string]string{
+			"example.com":         "expiring",
+			"other.example.com":   "expiring",
+			"expired.example.com": "expired",
+			"missing.example.com": "missing",
+			"broken.example.com":  "broken",
+		}),
+	)
+	dynamicCli.PrependReactor("get", "secrets", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		if action.(k8stesting.GetAction).GetName() != "broken" {
+			return false, nil, nil
+		}
+		return true, nil, errors.New("connection refused")
+	})
+
+	fakeSender := &fakeCheckEventSender{}
+	logger, _ := logtest.NewNullLogger()
+	checker := NewCertificateExpiryChecker(logger, dynamicCli, "dev", config.CertificateExpiryCheck{}, fakeSender.send)
+	checker.nowFn = func() time.Time { return now }
+
+	// when
+	err := checker.check(context.Background())
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"error: TLS certificate in Secret 'default/expired' expired on Fri, 01 Jul 2022 11:00:00 UTC.",
+		"warning: TLS certificate in Secret 'default/expiring' expires in less than 30 day(s), on Thu, 21 Jul 2022 12:00:00 UTC.",
+		"error: TLS certificate in Secret 'default/expiring' is not valid for host 'other.example.com' used by Ingress 'web'.",
+	}, fakeSender.messages())
+
+	// when
+	fakeSender.sentEvents = nil
+	now = now.Add(2 * 24 * time.Hour)
+	err = checker.check(context.Background())
+
+	// then
+	require.NoError(t, err)
+	assert.Empty(t, fakeSender.messages(), "already reported issues shouldn't be sent again")
+
+	// when
+	fakeSender.skip = true
+	now = now.Add(12 * 24 * time.Hour)
+	err = checker.check(context.Background())
+
+	// then
+	require.NoError(t, err)
+	assert.Empty(t, fakeSender.messages())
+
+	// when
+	fakeSender.skip = false
+	err = checker.check(context.Background())
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"warning: TLS certificate in Secret 'default/expiring' expires in less than 7 day(s), on Thu, 21 Jul 2022 12:00:00 UTC.",
+	}, fakeSender.messages(), "skipped issues should be sent during the next check")
+
+	// when
+	err = dynamicCli.Resource(secretGVR).Namespace("default").Delete(context.Background(), "expired", metav1.DeleteOptions{})
+	require.NoError(t, err)
+	err = checker.check(context.Background())
+
+	// then
+	require.NoError(t, err)
+	assert.NotContains(t, checker.certificates, "default/expired", "state of deleted Secrets should be removed")
+	assert.Contains(t, checker.certificates, "default/expiring")
+}
+
+type fakeCheckEventSender struct {
+	skip       bool
+	sentEvents []events.Event
+}
+
+func (f *fakeCheckEventSender) send(_ context.Context, _ interface{}, event events.Event) bool {
+	if f.skip {
+		return false
+	}
+	f.sentEvents = append(f.sentEvents, event)
+	return true
+}
+
+func (f *fakeCheckEventSender) messages() []string {
+	var out []string
+	for _, event := range f.sentEvents {
+		for _, msg := range event.Messages {
+			out = append(out, event.Type.String()+": "+msg)
+		}
+	}
+	return out
+}
+
+func fixTLSSecret(t *testing.T, name, host string, notAfter time.Time) *coreV1.Secret {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: host},
+		DNSNames:     []string{host},
+		NotBefore:    notAfter.Add(-90 * 24 * time.Hour),
+		NotAfter:     notAfter,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	require.NoError(t, err)
+
+	return &coreV1.Secret{
+		TypeMeta:   metav1.TypeMeta{Kind: "Secret", APIVersion: "v1"},
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+		Type:       coreV1.SecretTypeTLS,
+		Data: map[string][]byte{
+			coreV1.TLSCertKey: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		},
+	}
+}
+
+func fixTLSIngress(name string, secretByHost map[string]string) *networkingV1.Ingress {
+	ing := &networkingV1.Ingress{
+		TypeMeta:   metav1.TypeMeta{Kind: "Ingress", APIVersion: "networking.k8s.io/v1"},
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+	}
+	for host, secret := range secretByHost {
+		ing.Spec.TLS = append(ing.Spec.TLS, networkingV1.IngressTLS{Hosts: []string{host}, SecretName: secret})
+	}
+	return ing
+}
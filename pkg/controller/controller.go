@@ -5,11 +5,14 @@ import (
 	"fmt"
 	"reflect"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/sirupsen/logrus"
 	coreV1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/client-go/dynamic"
@@ -17,6 +20,7 @@ import (
 	"k8s.io/client-go/tools/cache"
 
 	"github.com/kubeshop/botkube/internal/analytics"
+	"github.com/kubeshop/botkube/pkg/ack"
 	"github.com/kubeshop/botkube/pkg/config"
 	"github.com/kubeshop/botkube/pkg/events"
 	"github.com/kubeshop/botkube/pkg/filterengine"
@@ -75,11 +79,21 @@ type Controller struct {
 
 	dynamicCli dynamic.Interface
 	mapper     meta.RESTMapper
+	ackTracker *ack.Tracker
 
 	dynamicKubeInformerFactory dynamicinformer.DynamicSharedInformerFactory
 	resourceInformerMap        map[string]cache.SharedIndexInformer
 	observedEventKindsMap      map[EventKind]bool
 	observedUpdateEventsMap    map[KindNS]config.UpdateSetting
+	observedDeleteEventsMap    map[KindNS]config.DeleteSetting
+
+	ownerResolver   *OwnerResolver
+	ownerAggregator *ownerAggregator
+	incidentTracker *IncidentTracker
+
+	recheckMu sync.Mutex
+	// pendingRechecks stores the objects which are scheduled to be checked again by the filters.
+	pendingRechecks map[string]struct{}
 }
 
 // New create a new Controller instance.
@@ -89,6 +103,7 @@ func New(log logrus.FieldLogger,
 	filterEngine filterengine.FilterEngine,
 	dynamicCli dynamic.Interface,
 	mapper meta.RESTMapper,
+	ackTracker *ack.Tracker,
 	informersResyncPeriod time.Duration,
 	reporter AnalyticsReporter,
 ) *Controller {
@@ -99,6 +114,7 @@ func New(log logrus.FieldLogger,
 		filterEngine:          filterEngine,
 		dynamicCli:            dynamicCli,
 		mapper:                mapper,
+		ackTracker:            ackTracker,
 		informersResyncPeriod: informersResyncPeriod,
 		reporter:              reporter,
 	}
@@ -107,6 +123,9 @@ func New(log logrus.FieldLogger,
 // Start creates new informer controllers to watch k8s resources
 func (c *Controller) Start(ctx context.Context) error {
 	c.initInformerMap()
+	c.initOwnerRollup(ctx)
+	c.initIncidentTracker(ctx)
+	c.initChecks(ctx)
 
 	c.log.Info("Starting controller")
 	err := sendMessageToNotifiers(ctx, c.notifiers, fmt.Sprintf(controllerStartMsg, c.conf.Settings.ClusterName))
@@ -287,6 +306,19 @@ func (c *Controller) sendEvent(ctx context.Context, obj, oldObj interface{}, res
 		}
 	}
 
+	// Attach the last known manifest to delete events
+	if eventType == config.DeleteEvent {
+		// Check if all namespaces allowed
+		deleteSetting, exist := c.observedDeleteEventsMap[KindNS{Resource: resource, Namespace: "all"}]
+		if !exist {
+			// Check if specified namespace is allowed
+			deleteSetting, exist = c.observedDeleteEventsMap[KindNS{Resource: resource, Namespace: objectMeta.Namespace}]
+		}
+		if exist && deleteSetting.AttachManifest {
+			event.Manifest = c.deletedObjectManifest(obj)
+		}
+	}
+
 	// Filter events
 	event = c.filterEngine.Run(ctx, obj, event)
 	if event.Skip {
@@ -294,6 +326,14 @@ func (c *Controller) sendEvent(ctx context.Context, obj, oldObj interface{}, res
 		return
 	}
 
+	if event.RecheckAfter > 0 {
+		c.scheduleRecheck(ctx, obj, resource, event.RecheckAfter)
+	}
+
+	if c.incidentTracker != nil {
+		c.incidentTracker.ResolveByEvent(event)
+	}
+
 	// Skip unpromoted insignificant InfoEvents
 	if event.Type == config.InfoEvent {
 		c.log.Debugf("Skipping Insignificant InfoEvent: %#v", event)
@@ -311,7 +351,31 @@ func (c *Controller) sendEvent(ctx context.Context, obj, oldObj interface{}, res
 		c.log.Debug("Skipping Recommendations in Event Notifications")
 	}
 
-	// Send event over notifiers
+	if c.ownerResolver != nil {
+		owner, err := c.ownerResolver.TopOwner(ctx, obj)
+		if err != nil {
+			c.log.Errorf("while resolving top-level owner: %s", err.Error())
+		}
+		event.Owner = owner
+	}
+
+	if c.ownerAggregator != nil && c.ownerAggregator.Add(event) {
+		c.log.Debugf("Event aggregated on the %q owner level", event.Owner.String())
+		return
+	}
+
+	c.notify(ctx, event)
+}
+
+// notify sends a given event over notifiers.
+func (c *Controller) notify(ctx context.Context, event events.Event) {
+	if c.incidentTracker != nil {
+		c.incidentTracker.Open(&event)
+	}
+	if c.ackTracker != nil {
+		c.ackTracker.Track(&event)
+	}
+
 	anonymousEvent := analytics.AnonymizedEventDetailsFrom(event)
 	for _, n := range c.notifiers {
 		go func(n notifier.Notifier) {
@@ -335,6 +399,128 @@ func (c *Controller) sendEvent(ctx context.Context, obj, oldObj interface{}, res
 	}
 }
 
+// sendCheckEvent sends the event reported by a periodic check about a given object. The event goes through the same
+// notification settings, namespace configuration and filters as the events observed by the informers.
+// It returns false if the event was skipped.
+func (c *Controller) sendCheckEvent(ctx context.Context, obj interface{}, event events.Event) bool {
+	// Check if Notify disabled
+	if !config.Notify {
+		c.log.Debug("Skipping notification")
+		return false
+	}
+
+	if !c.isNamespaceObserved(event.Namespace, event.Resource) {
+		c.log.Debugf("Ignoring %q to %s/%v in %q namespace", event.Type, event.Resource, event.Name, event.Namespace)
+		return false
+	}
+
+	event = c.filterEngine.Run(ctx, obj, event)
+	if event.Skip {
+		c.log.Debugf("Skipping event: %#v", event)
+		return false
+	}
+
+	// check if Recommendations are disabled
+	if !c.conf.Sources.GetFirst().Recommendations {
+		event.Recommendations = nil
+	}
+
+	c.notify(ctx, event)
+	return true
+}
+
+// scheduleRecheck runs the filters again for the current state of the object after a given time.
+// Only one recheck is scheduled for a given object at a time.
+func (c *Controller) scheduleRecheck(ctx context.Context, obj interface{}, resource string, after time.Duration) {
+	unstrObj, ok := obj.(*unstructured.Unstructured)
+	if !ok {
+		c.log.Errorf("Failed to typecast object to Unstructured. Skipping recheck of %q", resource)
+		return
+	}
+	namespace, name := unstrObj.GetNamespace(), unstrObj.GetName()
+	key := fmt.Sprintf("%s/%s/%s", resource, namespace, name)
+
+	c.recheckMu.Lock()
+	defer c.recheckMu.Unlock()
+	if _, found := c.pendingRechecks[key]; found {
+		return
+	}
+	if c.pendingRechecks == nil {
+		c.pendingRechecks = map[string]struct{}{}
+	}
+	c.pendingRechecks[key] = struct{}{}
+
+	time.AfterFunc(after, func() {
+		defer analytics.ReportPanicIfOccurs(c.log, c.reporter)
+
+		c.recheckMu.Lock()
+		delete(c.pendingRechecks, key)
+		c.recheckMu.Unlock()
+
+		if ctx.Err() != nil {
+			return
+		}
+		c.recheck(ctx, resource, namespace, name)
+	})
+}
+
+// recheck runs the filters for the current state of the object and sends a warning event if they report any warnings.
+// The event is sent only if the warnings are configured for the resource in the object namespace, and the filters don't skip it.
+func (c *Controller) recheck(ctx context.Context, resource, namespace, name string) {
+	gvr, err := c.strToGVR(resource)
+	if err != nil {
+		c.log.Errorf("while rechecking %s %s/%s: %s", resource, namespace, name, err.Error())
+		return
+	}
+	obj, err := c.dynamicCli.Resource(gvr).Namespace(namespace).Get(ctx, name, metaV1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		c.log.Debugf("Skipping recheck of deleted %s %s/%s", resource, namespace, name)
+		return
+	}
+	if err != nil {
+		c.log.Errorf("while getting %s %s/%s for recheck: %s", resource, namespace, name, err.Error())
+		return
+	}
+
+	// Check if Notify disabled
+	if !config.Notify {
+		c.log.Debug("Skipping notification")
+		return
+	}
+
+	objectMeta, err := utils.GetObjectMetaData(ctx, c.dynamicCli, c.mapper, obj)
+	if err != nil {
+		c.log.Errorf("while getting object metadata: %s", err.Error())
+		return
+	}
+	// the recheck result is sent as a warning, so it's skipped if the warnings are not configured for the resource
+	if !c.shouldSendEvent(objectMeta.Namespace, resource, config.WarningEvent) {
+		c.log.Debugf("Ignoring %q to %s/%v in %q namespace", config.WarningEvent, resource, objectMeta.Name, objectMeta.Namespace)
+		return
+	}
+	event, err := events.New(objectMeta, obj, config.WarningEvent, resource, c.conf.Settings.ClusterName)
+	if err != nil {
+		c.log.Errorf("while creating new event: %s", err.Error())
+		return
+	}
+	event.Title = fmt.Sprintf("%s %s", resource, config.WarningEvent.String())
+
+	event = c.filterEngine.Run(ctx, obj, event)
+	if event.Skip {
+		c.log.Debugf("Skipping event: %#v", event)
+		return
+	}
+	if len(event.Warnings) == 0 {
+		c.log.Debugf("Recheck of %s %s/%s didn't report any warnings", resource, namespace, name)
+		return
+	}
+	if !c.conf.Sources.GetFirst().Recommendations {
+		event.Recommendations = nil
+	}
+
+	c.notify(ctx, event)
+}
+
 func (c *Controller) initInformerMap() {
 	if len(c.conf.Sources) == 0 {
 		return
@@ -348,6 +534,7 @@ func (c *Controller) initInformerMap() {
 	c.resourceInformerMap = make(map[string]cache.SharedIndexInformer)
 	c.observedEventKindsMap = make(map[EventKind]bool)
 	c.observedUpdateEventsMap = make(map[KindNS]config.UpdateSetting)
+	c.observedDeleteEventsMap = make(map[KindNS]config.DeleteSetting)
 
 	for _, v := range resources {
 		gvr, err := c.parseResourceArg(v.Name)
@@ -375,6 +562,12 @@ func (c *Controller) initInformerMap() {
 					c.observedUpdateEventsMap[KindNS{Resource: r.Name, Namespace: ns}] = r.UpdateSetting
 				}
 			}
+			// AllowedDeleteEventsMap entry is created only for DeleteEvent
+			if e == config.DeleteEvent {
+				for _, ns := range r.Namespaces.Include {
+					c.observedDeleteEventsMap[KindNS{Resource: r.Name, Namespace: ns}] = r.DeleteSetting
+				}
+			}
 		}
 
 		// For AllEvent type, add all events to map
@@ -384,6 +577,7 @@ func (c *Controller) initInformerMap() {
 				for _, ns := range r.Namespaces.Include {
 					c.observedEventKindsMap[EventKind{Resource: r.Name, Namespace: ns, EventType: ev}] = true
 					c.observedUpdateEventsMap[KindNS{Resource: r.Name, Namespace: ns}] = r.UpdateSetting
+					c.observedDeleteEventsMap[KindNS{Resource: r.Name, Namespace: ns}] = r.DeleteSetting
 				}
 			}
 		}
@@ -392,6 +586,105 @@ func (c *Controller) initInformerMap() {
 	c.log.Infof("Allowed UpdateEvents: %+v", c.observedUpdateEventsMap)
 }
 
+// initOwnerRollup initializes the top-level owner resolution and aggregation, if configured.
+func (c *Controller) initOwnerRollup(ctx context.Context) {
+	if len(c.conf.Sources) == 0 {
+		return
+	}
+
+	cfg := c.conf.Sources.GetFirst().Kubernetes.OwnerRollup
+	if !cfg.Enabled {
+		return
+	}
+
+	c.ownerResolver = NewOwnerResolver(c.log.WithField("component", "Owner Resolver"), c.dynamicCli, c.mapper, cfg.CacheTTL)
+	if cfg.Aggregation.Enabled {
+		c.ownerAggregator = newOwnerAggregator(cfg.Aggregation.Window, func(event events.Event) {
+			c.notify(ctx, event)
+		})
+	}
+}
+
+// initIncidentTracker initializes tracking of the error events as open incidents, if configured.
+func (c *Controller) initIncidentTracker(ctx context.Context) {
+	if len(c.conf.Sources) == 0 {
+		return
+	}
+
+	cfg := c.conf.Sources.GetFirst().Incidents
+	if !cfg.Enabled {
+		return
+	}
+
+	c.incidentTracker = NewIncidentTracker(c.log.WithField("component", "Incident Tracker"), c.dynamicCli, c.mapper, cfg.Interval, func(event events.Event) {
+		c.sendResolvedEvent(ctx, event)
+	})
+	go func() {
+		defer analytics.ReportPanicIfOccurs(c.log, c.reporter)
+		if err := c.incidentTracker.Run(ctx); err != nil {
+			c.log.Errorf("while running incident tracker: %s", err.Error())
+		}
+	}()
+}
+
+// sendResolvedEvent sends the event of the resolved incident, unless the notifications are disabled.
+func (c *Controller) sendResolvedEvent(ctx context.Context, event events.Event) {
+	if !config.Notify {
+		c.log.Debug("Skipping notification")
+		return
+	}
+	c.notify(ctx, event)
+}
+
+// initChecks starts the periodic checks of the cluster resources, if configured.
+func (c *Controller) initChecks(ctx context.Context) {
+	if len(c.conf.Sources) == 0 {
+		return
+	}
+
+	cfg := c.conf.Sources.GetFirst().Checks
+	clusterName := c.conf.Settings.ClusterName
+	type checker interface {
+		Run(ctx context.Context) error
+	}
+	var checkers []checker
+
+	if cfg.CertificateExpiry.Enabled {
+		checkers = append(checkers, NewCertificateExpiryChecker(c.log.WithField("component", "Certificate Expiry Checker"), c.dynamicCli, clusterName, cfg.CertificateExpiry, c.sendCheckEvent))
+	}
+	if cfg.HPASaturation.Enabled {
+		checkers = append(checkers, NewHPASaturationChecker(c.log.WithField("component", "HPA Saturation Checker"), c.dynamicCli, clusterName, cfg.HPASaturation, c.sendCheckEvent))
+	}
+	if cfg.ResourceQuotaUsage.Enabled {
+		checkers = append(checkers, NewResourceQuotaUsageChecker(c.log.WithField("component", "ResourceQuota Usage Checker"), c.dynamicCli, clusterName, cfg.ResourceQuotaUsage, c.sendCheckEvent))
+	}
+
+	for _, ch := range checkers {
+		go func(ch checker) {
+			defer analytics.ReportPanicIfOccurs(c.log, c.reporter)
+			if err := ch.Run(ctx); err != nil {
+				c.log.Errorf("while running periodic check: %s", err.Error())
+			}
+		}(ch)
+	}
+}
+
+// deletedObjectManifest returns the cleaned manifest of the deleted object, or empty string if it cannot be generated.
+func (c *Controller) deletedObjectManifest(obj interface{}) string {
+	unstrObj, ok := obj.(*unstructured.Unstructured)
+	if !ok {
+		c.log.Errorf("Failed to typecast object to Unstructured. Skipping manifest of deleted object")
+		return ""
+	}
+
+	manifest, err := utils.CleanManifest(unstrObj)
+	if err != nil {
+		c.log.Errorf("while getting manifest of deleted object: %s", err.Error())
+		return ""
+	}
+	return manifest
+}
+
 func (c *Controller) parseResourceArg(arg string) (schema.GroupVersionResource, error) {
 	gvr, err := c.strToGVR(arg)
 	if err != nil {
@@ -418,6 +711,22 @@ func (c *Controller) strToGVR(arg string) (schema.GroupVersionResource, error) {
 	}
 }
 
+// isNamespaceObserved returns true if the events of a given resource are observed in a given namespace.
+// Namespaces of the resources which aren't configured as the sources are always observed.
+func (c *Controller) isNamespaceObserved(namespace, resource string) bool {
+	configured := false
+	for kind := range c.observedEventKindsMap {
+		if kind.Resource != resource {
+			continue
+		}
+		configured = true
+		if kind.Namespace == "all" || kind.Namespace == namespace {
+			return true
+		}
+	}
+	return !configured
+}
+
 func (c *Controller) shouldSendEvent(namespace string, resource string, eventType config.EventType) bool {
 	eventMap := c.observedEventKindsMap
 	if eventMap == nil {
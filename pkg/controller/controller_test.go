@@ -1,16 +1,25 @@
 package controller
 
 import (
+	"context"
+	"sync"
 	"testing"
+	"time"
 
+	logtest "github.com/sirupsen/logrus/hooks/test"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic/fake"
 
+	"github.com/kubeshop/botkube/internal/analytics"
 	"github.com/kubeshop/botkube/pkg/config"
+	"github.com/kubeshop/botkube/pkg/events"
+	"github.com/kubeshop/botkube/pkg/filterengine"
+	"github.com/kubeshop/botkube/pkg/notifier"
 	"github.com/kubeshop/botkube/pkg/utils"
 )
 
@@ -224,3 +233,247 @@ func TestController_strToGVR(t *testing.T) {
 		})
 	}
 }
+
+func TestController_Recheck(t *testing.T) {
+	tests := []struct {
+		name           string
+		observedEvents []config.EventType
+		skip           bool
+		expectedSent   bool
+	}{
+		{
+			name:           "Warnings observed",
+			observedEvents: []config.EventType{config.CreateEvent, config.WarningEvent},
+			expectedSent:   true,
+		},
+		{
+			name:           "Warnings not observed",
+			observedEvents: []config.EventType{config.CreateEvent},
+		},
+		{
+			name:           "Event skipped by filters",
+			observedEvents: []config.EventType{config.CreateEvent, config.WarningEvent},
+			skip:           true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			svc := &v1.Service{
+				TypeMeta:   metav1.TypeMeta{Kind: "Service", APIVersion: "v1"},
+				ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default"},
+			}
+			scheme := runtime.NewScheme()
+			require.NoError(t, v1.AddToScheme(scheme))
+			dynamicCli := fake.NewSimpleDynamicClient(scheme, svc)
+
+			logger, _ := logtest.NewNullLogger()
+			filterEngine := filterengine.New(logger)
+			filterEngine.Register(&fakeWarningFilter{
+				warnings: map[string]string{"web": "Service 'web' does not have any ready endpoints"},
+				skip:     tc.skip,
+			})
+
+			fakeNotifier := &fakeSyncNotifier{}
+			conf := &config.Config{
+				Settings: config.Settings{ClusterName: "dev"},
+				Sources:  config.IndexableMap[config.Sources]{"default": {}},
+			}
+			c := New(logger, conf, []notifier.Notifier{fakeNotifier}, filterEngine, dynamicCli, nil, nil, 0, analytics.NewNoopReporter())
+			observedEventKindsMap := map[EventKind]bool{}
+			for _, eventType := range tc.observedEvents {
+				observedEventKindsMap[EventKind{Resource: "v1/services", Namespace: "all", EventType: eventType}] = true
+			}
+			c.SetObservedEventKindsMap(observedEventKindsMap)
+
+			// when
+			c.recheck(context.Background(), "v1/services", "default", "web")
+			c.recheck(context.Background(), "v1/services", "default", "missing")
+
+			// then
+			if !tc.expectedSent {
+				assert.Never(t, func() bool {
+					return len(fakeNotifier.Events()) > 0
+				}, 100*time.Millisecond, 10*time.Millisecond)
+				return
+			}
+			require.Eventually(t, func() bool {
+				return len(fakeNotifier.Events()) > 0
+			}, time.Second, 10*time.Millisecond)
+			sent := fakeNotifier.Events()
+			require.Len(t, sent, 1)
+			assert.Equal(t, config.WarningEvent, sent[0].Type)
+			assert.Equal(t, "v1/services warning", sent[0].Title)
+			assert.Equal(t, []string{"Service 'web' does not have any ready endpoints"}, sent[0].Warnings)
+		})
+	}
+}
+
+func TestController_SendCheckEvent(t *testing.T) {
+	tests := []struct {
+		name         string
+		namespace    string
+		resource     string
+		notify       bool
+		expectedSent bool
+	}{
+		{
+			name:         "Observed namespace",
+			namespace:    "dev",
+			resource:     "v1/secrets",
+			notify:       true,
+			expectedSent: true,
+		},
+		{
+			name:      "Namespace not observed",
+			namespace: "prod",
+			resource:  "v1/secrets",
+			notify:    true,
+		},
+		{
+			name:         "Resource not configured",
+			namespace:    "prod",
+			resource:     "v1/resourcequotas",
+			notify:       true,
+			expectedSent: true,
+		},
+		{
+			name:      "Notifications disabled",
+			namespace: "dev",
+			resource:  "v1/secrets",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			config.Notify = tc.notify
+			defer func() { config.Notify = true }()
+
+			logger, _ := logtest.NewNullLogger()
+			fakeNotifier := &fakeSyncNotifier{}
+			conf := &config.Config{
+				Sources: config.IndexableMap[config.Sources]{"default": {}},
+			}
+			c := New(logger, conf, []notifier.Notifier{fakeNotifier}, filterengine.New(logger), nil, nil, nil, 0, analytics.NewNoopReporter())
+			c.SetObservedEventKindsMap(map[EventKind]bool{
+				{Resource: "v1/secrets", Namespace: "dev", EventType: config.CreateEvent}: true,
+			})
+
+			// when
+			sent := c.sendCheckEvent(context.Background(), nil, events.Event{
+				Name:      "cert",
+				Namespace: tc.namespace,
+				Resource:  tc.resource,
+				Type:      config.WarningEvent,
+			})
+
+			// then
+			assert.Equal(t, tc.expectedSent, sent)
+			if !tc.expectedSent {
+				return
+			}
+			assert.Eventually(t, func() bool {
+				return len(fakeNotifier.Events()) == 1
+			}, time.Second, 10*time.Millisecond)
+		})
+	}
+}
+
+func TestController_SendResolvedEvent(t *testing.T) {
+	tests := []struct {
+		name         string
+		notify       bool
+		expectedSent int
+	}{
+		{
+			name:         "Notifications enabled",
+			notify:       true,
+			expectedSent: 1,
+		},
+		{
+			name:   "Notifications disabled",
+			notify: false,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			config.Notify = tc.notify
+			defer func() { config.Notify = true }()
+
+			logger, _ := logtest.NewNullLogger()
+			fakeNotifier := &fakeSyncNotifier{}
+			c := New(logger, &config.Config{}, []notifier.Notifier{fakeNotifier}, filterengine.New(logger), nil, nil, nil, 0, analytics.NewNoopReporter())
+
+			// when
+			c.sendResolvedEvent(context.Background(), events.Event{
+				Name: "node-1",
+				Type: config.ResolvedEvent,
+			})
+
+			// then
+			if tc.expectedSent == 0 {
+				assert.Empty(t, fakeNotifier.Events())
+				return
+			}
+			assert.Eventually(t, func() bool {
+				return len(fakeNotifier.Events()) == tc.expectedSent
+			}, time.Second, 10*time.Millisecond)
+		})
+	}
+}
+
+type fakeWarningFilter struct {
+	// warnings maps the object name to the reported warning.
+	warnings map[string]string
+	// skip marks all events as skipped.
+	skip bool
+}
+
+func (f *fakeWarningFilter) Run(_ context.Context, _ interface{}, event *events.Event) error {
+	if warning, found := f.warnings[event.Name]; found {
+		event.Warnings = append(event.Warnings, warning)
+	}
+	if f.skip {
+		event.Skip = true
+	}
+	return nil
+}
+
+func (f *fakeWarningFilter) Name() string {
+	return "FakeWarningFilter"
+}
+
+func (f *fakeWarningFilter) Describe() string {
+	return "Adds configured warnings."
+}
+
+type fakeSyncNotifier struct {
+	mu     sync.Mutex
+	events []events.Event
+}
+
+func (f *fakeSyncNotifier) SendEvent(_ context.Context, event events.Event) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.events = append(f.events, event)
+	return nil
+}
+
+func (f *fakeSyncNotifier) SendMessage(context.Context, string) error {
+	return nil
+}
+
+func (f *fakeSyncNotifier) IntegrationName() config.CommPlatformIntegration {
+	return config.SlackCommPlatformIntegration
+}
+
+func (f *fakeSyncNotifier) Type() config.IntegrationType {
+	return config.BotIntegrationType
+}
+
+func (f *fakeSyncNotifier) Events() []events.Event {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]events.Event{}, f.events...)
+}
@@ -0,0 +1,198 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	autoscalingV2 "k8s.io/api/autoscaling/v2"
+	coreV1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+
+	"github.com/kubeshop/botkube/pkg/config"
+	"github.com/kubeshop/botkube/pkg/events"
+	"github.com/kubeshop/botkube/pkg/utils"
+)
+
+const (
+	defaultHPASaturationCheckInterval = time.Minute
+	defaultHPAMaxReplicasWindow       = 15 * time.Minute
+
+	// hpaTooManyReplicasReason is the reason of the ScalingLimited condition set when the desired replica count
+	// is more than maxReplicas. Other reasons, such as TooFewReplicas, don't indicate saturation.
+	hpaTooManyReplicasReason = "TooManyReplicas"
+)
+
+var (
+	hpaGVR = schema.GroupVersionResource{
+		Group:    "autoscaling",
+		Version:  "v2",
+		Resource: "horizontalpodautoscalers",
+	}
+	// hpaFallbackGVR is used for clusters older than Kubernetes 1.23, which don't serve autoscaling/v2.
+	hpaFallbackGVR = schema.GroupVersionResource{
+		Group:    "autoscaling",
+		Version:  "v2beta2",
+		Resource: "horizontalpodautoscalers",
+	}
+)
+
+// HPASaturationChecker periodically checks HorizontalPodAutoscalers and sends events
+// if they run at maxReplicas for too long or cannot scale.
+type HPASaturationChecker struct {
+	log         logrus.FieldLogger
+	dynamicCli  dynamic.Interface
+	cfg         config.HPASaturationCheck
+	clusterName string
+	sendFn      sendCheckEventFn
+	nowFn       func() time.Time
+
+	// atMaxSince stores the time since a given HPA runs at maxReplicas.
+	atMaxSince map[string]time.Time
+	// reported stores issues which are already reported and still persist.
+	reported map[string]struct{}
+}
+
+// NewHPASaturationChecker creates a new instance of the HPA Saturation Checker.
+func NewHPASaturationChecker(log logrus.FieldLogger, dynamicCli dynamic.Interface, clusterName string, cfg config.HPASaturationCheck, sendFn sendCheckEventFn) *HPASaturationChecker {
+	if cfg.Interval == 0 {
+		cfg.Interval = defaultHPASaturationCheckInterval
+	}
+	if cfg.MaxReplicasWindow == 0 {
+		cfg.MaxReplicasWindow = defaultHPAMaxReplicasWindow
+	}
+
+	return &HPASaturationChecker{
+		log:         log,
+		dynamicCli:  dynamicCli,
+		cfg:         cfg,
+		clusterName: clusterName,
+		sendFn:      sendFn,
+		nowFn:       time.Now,
+		atMaxSince:  map[string]time.Time{},
+		reported:    map[string]struct{}{},
+	}
+}
+
+// Run runs the HPA Saturation Checker and checks HorizontalPodAutoscalers periodically.
+func (c *HPASaturationChecker) Run(ctx context.Context) error {
+	c.log.Info("Starting checker")
+	// Check at startup
+	if err := c.check(ctx); err != nil {
+		c.log.Errorf("while checking HorizontalPodAutoscalers: %s", err.Error())
+	}
+
+	ticker := time.NewTicker(c.cfg.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			c.log.Info("Shutdown requested. Finishing...")
+			return nil
+		case <-ticker.C:
+			if err := c.check(ctx); err != nil {
+				c.log.Errorf("while checking HorizontalPodAutoscalers: %s", err.Error())
+			}
+		}
+	}
+}
+
+func (c *HPASaturationChecker) check(ctx context.Context) error {
+	items, err := c.listHPAs(ctx)
+	if err != nil {
+		return err
+	}
+
+	now := c.nowFn()
+	atMaxSince := map[string]time.Time{}
+	// issues which don't persist anymore are removed, so they can be reported again
+	stillReported := map[string]struct{}{}
+	var toSend []pendingEvent
+
+	for i := range items {
+		obj := &items[i]
+		var hpa autoscalingV2.HorizontalPodAutoscaler
+		if err := utils.TransformIntoTypedObject(obj, &hpa); err != nil {
+			return fmt.Errorf("while transforming object type %T into type: %T: %w", obj, hpa, err)
+		}
+		report := func(key string, eventType config.EventType, reason, msg string) {
+			if _, found := c.reported[key]; found {
+				stillReported[key] = struct{}{}
+				return
+			}
+			toSend = append(toSend, pendingEvent{key: key, obj: obj, event: c.newEvent(hpa, eventType, reason, msg)})
+		}
+
+		hpaKey := fmt.Sprintf("%s/%s", hpa.Namespace, hpa.Name)
+
+		if hpa.Spec.MaxReplicas > 0 && hpa.Status.CurrentReplicas >= hpa.Spec.MaxReplicas {
+			since, found := c.atMaxSince[hpaKey]
+			if !found {
+				since = now
+			}
+			atMaxSince[hpaKey] = since
+
+			if now.Sub(since) >= c.cfg.MaxReplicasWindow {
+				report(hpaKey+"/maxReplicas", config.WarningEvent, "MaxReplicasReached", fmt.Sprintf(
+					"HorizontalPodAutoscaler '%s' has been running at maxReplicas (%d) for more than %s.", hpaKey, hpa.Spec.MaxReplicas, c.cfg.MaxReplicasWindow,
+				))
+			}
+		}
+
+		for _, cond := range hpa.Status.Conditions {
+			switch {
+			case cond.Type == autoscalingV2.ScalingLimited && cond.Status == coreV1.ConditionTrue && cond.Reason == hpaTooManyReplicasReason:
+				report(hpaKey+"/ScalingLimited/"+cond.Reason, config.WarningEvent, cond.Reason, fmt.Sprintf(
+					"HorizontalPodAutoscaler '%s' scaling is limited: %s", hpaKey, cond.Message,
+				))
+			case cond.Type == autoscalingV2.AbleToScale && cond.Status == coreV1.ConditionFalse:
+				report(hpaKey+"/AbleToScale/"+cond.Reason, config.ErrorEvent, cond.Reason, fmt.Sprintf(
+					"HorizontalPodAutoscaler '%s' is not able to scale: %s", hpaKey, cond.Message,
+				))
+			}
+		}
+	}
+
+	c.atMaxSince = atMaxSince
+	c.reported = stillReported
+	sendPendingEvents(ctx, c.sendFn, toSend, c.reported)
+	return nil
+}
+
+func (c *HPASaturationChecker) listHPAs(ctx context.Context) ([]unstructured.Unstructured, error) {
+	list, err := c.dynamicCli.Resource(hpaGVR).List(ctx, metaV1.ListOptions{})
+	if apierrors.IsNotFound(err) {
+		list, err = c.dynamicCli.Resource(hpaFallbackGVR).List(ctx, metaV1.ListOptions{})
+	}
+	if err != nil {
+		return nil, fmt.Errorf("while listing HorizontalPodAutoscalers: %w", err)
+	}
+
+	return list.Items, nil
+}
+
+func (c *HPASaturationChecker) newEvent(hpa autoscalingV2.HorizontalPodAutoscaler, eventType config.EventType, reason, msg string) events.Event {
+	resource := utils.GVRToString(hpaGVR)
+	return events.Event{
+		TypeMeta: metaV1.TypeMeta{
+			Kind:       "HorizontalPodAutoscaler",
+			APIVersion: hpaGVR.GroupVersion().String(),
+		},
+		Title:     fmt.Sprintf("%s %s", resource, eventType.String()),
+		Name:      hpa.Name,
+		Namespace: hpa.Namespace,
+		Messages:  []string{msg},
+		Type:      eventType,
+		Reason:    reason,
+		Level:     events.LevelMap[eventType],
+		Cluster:   c.clusterName,
+		TimeStamp: c.nowFn(),
+		Resource:  resource,
+	}
+}
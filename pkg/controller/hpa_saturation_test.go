@@ -0,0 +1,140 @@
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	logtest "github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	autoscalingV2 "k8s.io/api/autoscaling/v2"
+	coreV1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/dynamic/fake"
+
+	"github.com/kubeshop/botkube/pkg/config"
+)
+
+func TestHPASaturationChecker(t *testing.T) {
+	// given
+	now := time.Date(2022, 7, 1, 12, 0, 0, 0, time.UTC)
+
+	hpa := &autoscalingV2.HorizontalPodAutoscaler{
+		TypeMeta:   metav1.TypeMeta{Kind: "HorizontalPodAutoscaler", APIVersion: "autoscaling/v2"},
+		ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default"},
+		Spec:       autoscalingV2.HorizontalPodAutoscalerSpec{MaxReplicas: 5},
+		Status: autoscalingV2.HorizontalPodAutoscalerStatus{
+			CurrentReplicas: 5,
+			Conditions: []autoscalingV2.HorizontalPodAutoscalerCondition{
+				{
+					Type:    autoscalingV2.AbleToScale,
+					Status:  coreV1.ConditionFalse,
+					Reason:  "FailedGetScale",
+					Message: "the HPA controller was unable to get the target's current scale",
+				},
+			},
+		},
+	}
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, autoscalingV2.AddToScheme(scheme))
+	dynamicCli := fake.NewSimpleDynamicClient(scheme, hpa)
+
+	fakeSender := &fakeCheckEventSender{}
+	logger, _ := logtest.NewNullLogger()
+	checker := NewHPASaturationChecker(logger, dynamicCli, "dev", config.HPASaturationCheck{
+		MaxReplicasWindow: 10 * time.Minute,
+	}, fakeSender.send)
+	checker.nowFn = func() time.Time { return now }
+
+	// when
+	err := checker.check(context.Background())
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"error: HorizontalPodAutoscaler 'default/web' is not able to scale: the HPA controller was unable to get the target's current scale",
+	}, fakeSender.messages())
+
+	// when
+	fakeSender.sentEvents = nil
+	now = now.Add(10 * time.Minute)
+	err = checker.check(context.Background())
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"warning: HorizontalPodAutoscaler 'default/web' has been running at maxReplicas (5) for more than 10m0s.",
+	}, fakeSender.messages())
+
+	// when
+	fakeSender.sentEvents = nil
+	now = now.Add(10 * time.Minute)
+	err = checker.check(context.Background())
+
+	// then
+	require.NoError(t, err)
+	assert.Empty(t, fakeSender.messages(), "already reported issues shouldn't be sent again")
+}
+
+func TestHPASaturationChecker_ScalingLimited(t *testing.T) {
+	tests := []struct {
+		name             string
+		reason           string
+		expectedMessages []string
+	}{
+		{
+			name:   "Too many replicas",
+			reason: "TooManyReplicas",
+			expectedMessages: []string{
+				"warning: HorizontalPodAutoscaler 'default/web' scaling is limited: the desired replica count is more than the maximum replica count",
+			},
+		},
+		{
+			name:   "Too few replicas",
+			reason: "TooFewReplicas",
+		},
+		{
+			name:   "Scale down limit",
+			reason: "ScaleDownLimit",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			hpa := &autoscalingV2.HorizontalPodAutoscaler{
+				TypeMeta:   metav1.TypeMeta{Kind: "HorizontalPodAutoscaler", APIVersion: "autoscaling/v2"},
+				ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default"},
+				Spec:       autoscalingV2.HorizontalPodAutoscalerSpec{MaxReplicas: 5},
+				Status: autoscalingV2.HorizontalPodAutoscalerStatus{
+					CurrentReplicas: 3,
+					Conditions: []autoscalingV2.HorizontalPodAutoscalerCondition{
+						{
+							Type:    autoscalingV2.ScalingLimited,
+							Status:  coreV1.ConditionTrue,
+							Reason:  tc.reason,
+							Message: "the desired replica count is more than the maximum replica count",
+						},
+					},
+				},
+			}
+
+			scheme := runtime.NewScheme()
+			require.NoError(t, autoscalingV2.AddToScheme(scheme))
+			dynamicCli := fake.NewSimpleDynamicClient(scheme, hpa)
+
+			fakeSender := &fakeCheckEventSender{}
+			logger, _ := logtest.NewNullLogger()
+			checker := NewHPASaturationChecker(logger, dynamicCli, "dev", config.HPASaturationCheck{}, fakeSender.send)
+
+			// when
+			err := checker.check(context.Background())
+
+			// then
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedMessages, fakeSender.messages())
+		})
+	}
+}
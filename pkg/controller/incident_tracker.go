@@ -0,0 +1,320 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+
+	"github.com/kubeshop/botkube/pkg/config"
+	"github.com/kubeshop/botkube/pkg/events"
+	"github.com/kubeshop/botkube/pkg/filterengine/filters"
+	"github.com/kubeshop/botkube/pkg/multierror"
+	"github.com/kubeshop/botkube/pkg/utils"
+)
+
+const (
+	defaultIncidentCheckInterval = time.Minute
+	// maxIncidentAge is the time after which the unresolved incidents are forgotten.
+	maxIncidentAge = 72 * time.Hour
+	// requiredHealthyChecks is the number of consecutive checks in which the object needs to be healthy,
+	// so the incidents of the crash looping Pods are not resolved between the restarts.
+	requiredHealthyChecks = 2
+)
+
+// incidentResolvingReasons maps reasons of the events which resolve incidents to the reasons of the resolved incidents.
+var incidentResolvingReasons = map[string]string{
+	filters.NodeReady: filters.NodeNotReady,
+}
+
+// healthCheckers contains functions which check the health of the objects of a given kind.
+var healthCheckers = map[string]func(obj *unstructured.Unstructured) bool{
+	"Pod":         isPodHealthy,
+	"Node":        isNodeHealthy,
+	"Deployment":  isDeploymentHealthy,
+	"StatefulSet": isStatefulSetHealthy,
+	"DaemonSet":   isDaemonSetHealthy,
+}
+
+// incident is an error reported for a given object.
+type incident struct {
+	event         events.Event
+	openedAt      time.Time
+	healthyChecks int
+}
+
+// IncidentTracker tracks error events as open incidents keyed by object and reason,
+// and sends resolved events once the objects return to healthy.
+type IncidentTracker struct {
+	log        logrus.FieldLogger
+	dynamicCli dynamic.Interface
+	mapper     meta.RESTMapper
+	interval   time.Duration
+	sendFn     func(events.Event)
+	nowFn      func() time.Time
+
+	mu        sync.Mutex
+	incidents map[string]*incident
+}
+
+// NewIncidentTracker creates a new IncidentTracker instance.
+func NewIncidentTracker(log logrus.FieldLogger, dynamicCli dynamic.Interface, mapper meta.RESTMapper, interval time.Duration, sendFn func(events.Event)) *IncidentTracker {
+	if interval == 0 {
+		interval = defaultIncidentCheckInterval
+	}
+	return &IncidentTracker{
+		log:        log,
+		dynamicCli: dynamicCli,
+		mapper:     mapper,
+		interval:   interval,
+		sendFn:     sendFn,
+		nowFn:      time.Now,
+		incidents:  map[string]*incident{},
+	}
+}
+
+// Run checks the health of the objects with open incidents periodically.
+func (t *IncidentTracker) Run(ctx context.Context) error {
+	t.log.Info("Starting incident tracker")
+	ticker := time.NewTicker(t.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			t.log.Info("Shutdown requested. Finishing...")
+			return nil
+		case <-ticker.C:
+			if err := t.check(ctx); err != nil {
+				t.log.Errorf("while checking incidents: %s", err.Error())
+			}
+		}
+	}
+}
+
+// Open opens the incident for a given error event and sets its key.
+// Events of the already open incidents, and events which cannot be resolved, are not tracked.
+func (t *IncidentTracker) Open(event *events.Event) {
+	if event.Type != config.ErrorEvent || !isResolvable(*event) {
+		return
+	}
+
+	key := incidentKey(*event, event.Reason)
+	event.IncidentKey = key
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if _, exists := t.incidents[key]; exists {
+		return
+	}
+	t.incidents[key] = &incident{event: *event, openedAt: t.nowFn()}
+	t.log.Debugf("Incident %q opened", key)
+}
+
+// ResolveByEvent resolves the incident if a given event reports the object recovery, e.g. `NodeReady`.
+func (t *IncidentTracker) ResolveByEvent(event events.Event) {
+	resolvedReason, ok := incidentResolvingReasons[event.Reason]
+	if !ok {
+		return
+	}
+
+	t.resolve(incidentKey(event, resolvedReason), func(objName string) string {
+		return fmt.Sprintf("%s reported %s.", objName, event.Reason)
+	})
+}
+
+// check resolves incidents of the healthy and deleted objects, and forgets the outdated ones.
+// A failed check of one incident doesn't stop checking the other ones.
+func (t *IncidentTracker) check(ctx context.Context) error {
+	t.mu.Lock()
+	var keys []string
+	for key, inc := range t.incidents {
+		if t.nowFn().Sub(inc.openedAt) > maxIncidentAge {
+			t.log.Debugf("Incident %q not resolved within %s. Forgetting...", key, maxIncidentAge)
+			delete(t.incidents, key)
+			continue
+		}
+		keys = append(keys, key)
+	}
+	t.mu.Unlock()
+
+	var errs error
+	for _, key := range keys {
+		if err := t.checkIncident(ctx, key); err != nil {
+			errs = multierror.Append(errs, fmt.Errorf("while checking incident %q: %w", key, err))
+		}
+	}
+	return errs
+}
+
+func (t *IncidentTracker) checkIncident(ctx context.Context, key string) error {
+	t.mu.Lock()
+	inc, exists := t.incidents[key]
+	if !exists {
+		t.mu.Unlock()
+		return nil
+	}
+	event := inc.event
+	t.mu.Unlock()
+
+	isHealthy, ok := healthCheckers[event.Kind]
+	if !ok {
+		return nil
+	}
+
+	gvr, err := utils.GetResourceFromKind(t.mapper, schema.FromAPIVersionAndKind(event.APIVersion, event.Kind))
+	if err != nil {
+		return err
+	}
+	obj, err := t.dynamicCli.Resource(gvr).Namespace(event.Namespace).Get(ctx, event.Name, metaV1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		t.resolve(key, func(objName string) string {
+			return fmt.Sprintf("%s has been deleted.", objName)
+		})
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("while getting %s: %w", objectName(event), err)
+	}
+
+	t.mu.Lock()
+	if !isHealthy(obj) {
+		inc.healthyChecks = 0
+		t.mu.Unlock()
+		return nil
+	}
+	inc.healthyChecks++
+	healthyChecks := inc.healthyChecks
+	t.mu.Unlock()
+
+	if healthyChecks < requiredHealthyChecks {
+		return nil
+	}
+	t.resolve(key, func(objName string) string {
+		return fmt.Sprintf("%s is healthy again.", objName)
+	})
+	return nil
+}
+
+// resolve closes the incident and sends the resolved event.
+func (t *IncidentTracker) resolve(key string, msgFn func(objName string) string) {
+	t.mu.Lock()
+	inc, exists := t.incidents[key]
+	delete(t.incidents, key)
+	t.mu.Unlock()
+	if !exists {
+		return
+	}
+
+	now := t.nowFn()
+	open := inc.event
+	msg := fmt.Sprintf("%s Incident %q resolved after %s.", msgFn(objectName(open)), open.Reason, now.Sub(inc.openedAt).Truncate(time.Second))
+
+	t.log.Debugf("Incident %q resolved", key)
+	t.sendFn(events.Event{
+		TypeMeta:       open.TypeMeta,
+		Title:          fmt.Sprintf("%s %s", open.Resource, config.ResolvedEvent),
+		Name:           open.Name,
+		Namespace:      open.Namespace,
+		Messages:       []string{msg},
+		Type:           config.ResolvedEvent,
+		Reason:         open.Reason,
+		Level:          config.Info,
+		Cluster:        open.Cluster,
+		Channel:        open.Channel,
+		TimeStamp:      now,
+		Resource:       open.Resource,
+		Owner:          open.Owner,
+		RoutedChannels: open.RoutedChannels,
+		IncidentKey:    key,
+	})
+}
+
+func isResolvable(event events.Event) bool {
+	if _, ok := healthCheckers[event.Kind]; ok {
+		return true
+	}
+	for _, resolvedReason := range incidentResolvingReasons {
+		if event.Reason == resolvedReason {
+			return true
+		}
+	}
+	return false
+}
+
+// incidentKey returns the key of the incident in the `kind/namespace/name/reason` format.
+func incidentKey(event events.Event, reason string) string {
+	return fmt.Sprintf("%s/%s/%s/%s", strings.ToLower(event.Kind), event.Namespace, event.Name, reason)
+}
+
+// objectName returns the object of the event in the `Kind namespace/name` format.
+func objectName(event events.Event) string {
+	if event.Namespace == "" {
+		return fmt.Sprintf("%s %s", event.Kind, event.Name)
+	}
+	return fmt.Sprintf("%s %s/%s", event.Kind, event.Namespace, event.Name)
+}
+
+func isPodHealthy(obj *unstructured.Unstructured) bool {
+	phase, _, _ := unstructured.NestedString(obj.Object, "status", "phase")
+	switch phase {
+	case "Succeeded":
+		return true
+	case "Running":
+		return hasCondition(obj, "Ready")
+	}
+	return false
+}
+
+func isNodeHealthy(obj *unstructured.Unstructured) bool {
+	return hasCondition(obj, "Ready")
+}
+
+func isDeploymentHealthy(obj *unstructured.Unstructured) bool {
+	return replicasReady(obj, "availableReplicas")
+}
+
+func isStatefulSetHealthy(obj *unstructured.Unstructured) bool {
+	return replicasReady(obj, "readyReplicas")
+}
+
+func isDaemonSetHealthy(obj *unstructured.Unstructured) bool {
+	desired, _, _ := unstructured.NestedInt64(obj.Object, "status", "desiredNumberScheduled")
+	ready, _, _ := unstructured.NestedInt64(obj.Object, "status", "numberReady")
+	return ready >= desired
+}
+
+// replicasReady checks if a given status field reached the desired number of replicas, which defaults to 1.
+func replicasReady(obj *unstructured.Unstructured, statusField string) bool {
+	desired, found, _ := unstructured.NestedInt64(obj.Object, "spec", "replicas")
+	if !found {
+		desired = 1
+	}
+	ready, _, _ := unstructured.NestedInt64(obj.Object, "status", statusField)
+	return ready >= desired
+}
+
+// hasCondition checks if the object has the condition with a given type and the `True` status.
+func hasCondition(obj *unstructured.Unstructured, condType string) bool {
+	conditions, _, _ := unstructured.NestedSlice(obj.Object, "status", "conditions")
+	for _, item := range conditions {
+		cond, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if cond["type"] == condType {
+			return cond["status"] == "True"
+		}
+	}
+	return false
+}
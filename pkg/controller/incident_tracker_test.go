@@ -0,0 +1,148 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	logtest "github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	coreV1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/dynamic/fake"
+	k8stesting "k8s.io/client-go/testing"
+
+	"github.com/kubeshop/botkube/pkg/config"
+	"github.com/kubeshop/botkube/pkg/events"
+)
+
+func TestIncidentTracker_ResolveByEvent(t *testing.T) {
+	// given
+	now := time.Date(2022, 7, 1, 12, 0, 0, 0, time.UTC)
+	var sent []events.Event
+	logger, _ := logtest.NewNullLogger()
+	tracker := NewIncidentTracker(logger, nil, nil, 0, func(event events.Event) {
+		sent = append(sent, event)
+	})
+	tracker.nowFn = func() time.Time { return now }
+
+	notReady := fixIncidentEvent("Node", "", "node-1", config.ErrorEvent, "NodeNotReady")
+	created := fixIncidentEvent("Node", "", "node-1", config.CreateEvent, "")
+
+	// when
+	tracker.Open(&notReady)
+	tracker.Open(&created)
+
+	// then
+	assert.Equal(t, "node//node-1/NodeNotReady", notReady.IncidentKey)
+	assert.Empty(t, created.IncidentKey, "only error events should open incidents")
+
+	// when
+	now = now.Add(5 * time.Minute)
+	tracker.ResolveByEvent(fixIncidentEvent("Node", "", "node-1", config.InfoEvent, "NodeReady"))
+	tracker.ResolveByEvent(fixIncidentEvent("Node", "", "node-1", config.InfoEvent, "NodeReady"))
+
+	// then
+	require.Len(t, sent, 1, "incident should be resolved only once")
+	assert.Equal(t, config.ResolvedEvent, sent[0].Type)
+	assert.Equal(t, config.Info, sent[0].Level)
+	assert.Equal(t, "v1/nodes resolved", sent[0].Title)
+	assert.Equal(t, notReady.IncidentKey, sent[0].IncidentKey)
+	assert.Equal(t, []string{`Node node-1 reported NodeReady. Incident "NodeNotReady" resolved after 5m0s.`}, sent[0].Messages)
+}
+
+func TestIncidentTracker_Check(t *testing.T) {
+	// given
+	now := time.Date(2022, 7, 1, 12, 0, 0, 0, time.UTC)
+	crashing := fixIncidentPod("crashing", false)
+	recovered := fixIncidentPod("recovered", true)
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, coreV1.AddToScheme(scheme))
+	mapper := meta.NewDefaultRESTMapper(nil)
+	mapper.Add(coreV1.SchemeGroupVersion.WithKind("Pod"), meta.RESTScopeNamespace)
+	dynamicCli := fake.NewSimpleDynamicClient(scheme, crashing, recovered)
+	dynamicCli.PrependReactor("get", "pods", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		if action.(k8stesting.GetAction).GetName() != "broken" {
+			return false, nil, nil
+		}
+		return true, nil, errors.New("connection refused")
+	})
+
+	var sent []events.Event
+	logger, _ := logtest.NewNullLogger()
+	tracker := NewIncidentTracker(logger, dynamicCli, mapper, 0, func(event events.Event) {
+		sent = append(sent, event)
+	})
+	tracker.nowFn = func() time.Time { return now }
+
+	for _, name := range []string{"broken", "crashing", "recovered", "deleted"} {
+		event := fixIncidentEvent("Pod", "default", name, config.ErrorEvent, "BackOff")
+		tracker.Open(&event)
+	}
+
+	// when
+	now = now.Add(time.Minute)
+	err := tracker.check(context.Background())
+
+	// then
+	assert.EqualError(t, err, `1 error occurred:
+	* while checking incident "pod/default/broken/BackOff": while getting Pod default/broken: connection refused
+
+`)
+	require.Len(t, sent, 1, "failed check shouldn't stop checking other incidents")
+	assert.Equal(t, []string{`Pod default/deleted has been deleted. Incident "BackOff" resolved after 1m0s.`}, sent[0].Messages)
+
+	// when
+	now = now.Add(time.Minute)
+	err = tracker.check(context.Background())
+
+	// then
+	assert.Error(t, err)
+	require.Len(t, sent, 2)
+	assert.Equal(t, []string{`Pod default/recovered is healthy again. Incident "BackOff" resolved after 2m0s.`}, sent[1].Messages)
+
+	// when
+	now = now.Add(maxIncidentAge)
+	err = tracker.check(context.Background())
+
+	// then
+	require.NoError(t, err)
+	assert.Len(t, sent, 2, "outdated incidents should be forgotten without notification")
+	assert.Empty(t, tracker.incidents)
+}
+
+func fixIncidentEvent(kind, namespace, name string, eventType config.EventType, reason string) events.Event {
+	resource := "v1/pods"
+	if kind == "Node" {
+		resource = "v1/nodes"
+	}
+	return events.Event{
+		TypeMeta:  metav1.TypeMeta{Kind: kind, APIVersion: "v1"},
+		Name:      name,
+		Namespace: namespace,
+		Type:      eventType,
+		Reason:    reason,
+		Level:     config.Error,
+		Resource:  resource,
+	}
+}
+
+func fixIncidentPod(name string, ready bool) *coreV1.Pod {
+	status := coreV1.ConditionFalse
+	if ready {
+		status = coreV1.ConditionTrue
+	}
+	return &coreV1.Pod{
+		TypeMeta:   metav1.TypeMeta{Kind: "Pod", APIVersion: "v1"},
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+		Status: coreV1.PodStatus{
+			Phase:      coreV1.PodRunning,
+			Conditions: []coreV1.PodCondition{{Type: coreV1.PodReady, Status: status}},
+		},
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/kubeshop/botkube/pkg/events"
 	"github.com/kubeshop/botkube/pkg/notifier"
 )
 
@@ -22,3 +23,24 @@ func sendMessageToNotifiers(ctx context.Context, notifiers []notifier.Notifier,
 
 	return nil
 }
+
+// sendCheckEventFn sends the event reported by a periodic check about a given object.
+// It returns false if the event was skipped, e.g. because the notifications are disabled or the event was filtered out.
+type sendCheckEventFn func(ctx context.Context, obj interface{}, event events.Event) bool
+
+// pendingEvent is an event about the issue of a given object identified by the key.
+type pendingEvent struct {
+	key   string
+	obj   interface{}
+	event events.Event
+}
+
+// sendPendingEvents sends events and marks their keys as reported.
+// Events which were skipped are not marked, so they are sent again during the next check.
+func sendPendingEvents(ctx context.Context, sendFn sendCheckEventFn, pending []pendingEvent, reported map[string]struct{}) {
+	for _, p := range pending {
+		if sendFn(ctx, p.obj, p.event) {
+			reported[p.key] = struct{}{}
+		}
+	}
+}
@@ -0,0 +1,121 @@
+package controller
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/kubeshop/botkube/pkg/config"
+	"github.com/kubeshop/botkube/pkg/events"
+	"github.com/kubeshop/botkube/pkg/utils"
+)
+
+const defaultOwnerAggregationWindow = 30 * time.Second
+
+// ownerAggregator groups Pod events with the same reason and top-level owner received within the window,
+// and sends them as a single event, e.g. "3 Pods of deployment/web reported BackOff".
+type ownerAggregator struct {
+	window  time.Duration
+	sendFn  func(events.Event)
+	afterFn func(time.Duration, func())
+
+	mu     sync.Mutex
+	groups map[string][]events.Event
+}
+
+func newOwnerAggregator(window time.Duration, sendFn func(events.Event)) *ownerAggregator {
+	if window == 0 {
+		window = defaultOwnerAggregationWindow
+	}
+	return &ownerAggregator{
+		window: window,
+		sendFn: sendFn,
+		afterFn: func(d time.Duration, f func()) {
+			time.AfterFunc(d, f)
+		},
+		groups: map[string][]events.Event{},
+	}
+}
+
+// Add adds a given event to the aggregation group. It returns false if the event cannot be aggregated
+// and should be sent directly.
+func (a *ownerAggregator) Add(event events.Event) bool {
+	if event.Owner == nil || event.Kind != "Pod" {
+		return false
+	}
+	if event.Type != config.ErrorEvent && event.Type != config.WarningEvent {
+		return false
+	}
+
+	key := fmt.Sprintf("%s/%s/%s/%s", event.Namespace, event.Owner.String(), event.Type, event.Reason)
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	group, found := a.groups[key]
+	a.groups[key] = append(group, event)
+	if !found {
+		a.afterFn(a.window, func() {
+			a.flush(key)
+		})
+	}
+	return true
+}
+
+func (a *ownerAggregator) flush(key string) {
+	a.mu.Lock()
+	group := a.groups[key]
+	delete(a.groups, key)
+	a.mu.Unlock()
+
+	if len(group) == 0 {
+		return
+	}
+	a.sendFn(aggregateOwnerEvents(group))
+}
+
+// aggregateOwnerEvents merges events of Pods with the same top-level owner into a single event.
+func aggregateOwnerEvents(group []events.Event) events.Event {
+	var podNames []string
+	for _, event := range group {
+		if !utils.Contains(podNames, event.Name) {
+			podNames = append(podNames, event.Name)
+		}
+	}
+
+	// single Pod is reported as it is
+	if len(podNames) == 1 {
+		return group[len(group)-1]
+	}
+
+	first := group[0]
+	out := first
+	out.TypeMeta = first.Owner.TypeMeta
+	out.Name = first.Owner.Name
+	out.Messages = []string{fmt.Sprintf("%d Pods of %s reported %s: %s", len(podNames), first.Owner.String(), first.Reason, strings.Join(podNames, ", "))}
+	out.Recommendations = nil
+	out.Warnings = nil
+	out.Count = 0
+
+	for _, event := range group {
+		out.Messages = appendUnique(out.Messages, event.Messages...)
+		out.Recommendations = appendUnique(out.Recommendations, event.Recommendations...)
+		out.Warnings = appendUnique(out.Warnings, event.Warnings...)
+		out.Count += event.Count
+		if event.TimeStamp.After(out.TimeStamp) {
+			out.TimeStamp = event.TimeStamp
+		}
+	}
+
+	return out
+}
+
+func appendUnique(slice []string, items ...string) []string {
+	for _, item := range items {
+		if !utils.Contains(slice, item) {
+			slice = append(slice, item)
+		}
+	}
+	return slice
+}
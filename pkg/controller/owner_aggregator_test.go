@@ -0,0 +1,74 @@
+package controller
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kubeshop/botkube/pkg/config"
+	"github.com/kubeshop/botkube/pkg/events"
+)
+
+func TestOwnerAggregator(t *testing.T) {
+	// given
+	now := time.Date(2022, 7, 1, 12, 0, 0, 0, time.UTC)
+	web := &events.Owner{TypeMeta: metav1.TypeMeta{Kind: "Deployment", APIVersion: "apps/v1"}, Name: "web"}
+	api := &events.Owner{TypeMeta: metav1.TypeMeta{Kind: "Deployment", APIVersion: "apps/v1"}, Name: "api"}
+
+	fixEvent := func(pod string, owner *events.Owner, ts time.Time) events.Event {
+		return events.Event{
+			TypeMeta:  metav1.TypeMeta{Kind: "Pod", APIVersion: "v1"},
+			Name:      pod,
+			Namespace: "default",
+			Type:      config.ErrorEvent,
+			Reason:    "BackOff",
+			Messages:  []string{"Back-off restarting failed container"},
+			Count:     1,
+			TimeStamp: ts,
+			Owner:     owner,
+		}
+	}
+
+	var (
+		sent    []events.Event
+		flushes []func()
+	)
+	aggregator := newOwnerAggregator(time.Minute, func(event events.Event) {
+		sent = append(sent, event)
+	})
+	aggregator.afterFn = func(_ time.Duration, f func()) {
+		flushes = append(flushes, f)
+	}
+
+	// when
+	aggregated := []bool{
+		aggregator.Add(fixEvent("web-7f9c-xk2", web, now)),
+		aggregator.Add(fixEvent("web-7f9c-pq8", web, now.Add(time.Second))),
+		aggregator.Add(fixEvent("web-7f9c-xk2", web, now.Add(2*time.Second))),
+		aggregator.Add(fixEvent("web-7f9c-zz1", web, now.Add(3*time.Second))),
+		aggregator.Add(fixEvent("api-5d4b-ab1", api, now)),
+		aggregator.Add(fixEvent("standalone", nil, now)),
+	}
+	for _, flush := range flushes {
+		flush()
+	}
+
+	// then
+	assert.Equal(t, []bool{true, true, true, true, true, false}, aggregated)
+	require.Len(t, sent, 2)
+
+	assert.Equal(t, "Deployment", sent[0].Kind)
+	assert.Equal(t, "web", sent[0].Name)
+	assert.Equal(t, []string{
+		"3 Pods of deployment/web reported BackOff: web-7f9c-xk2, web-7f9c-pq8, web-7f9c-zz1",
+		"Back-off restarting failed container",
+	}, sent[0].Messages)
+	assert.Equal(t, int32(4), sent[0].Count)
+	assert.Equal(t, now.Add(3*time.Second), sent[0].TimeStamp)
+
+	// single Pod is sent as it is
+	assert.Equal(t, fixEvent("api-5d4b-ab1", api, now), sent[1])
+}
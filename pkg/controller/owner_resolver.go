@@ -0,0 +1,209 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	coreV1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/dynamic"
+
+	"github.com/kubeshop/botkube/pkg/events"
+	"github.com/kubeshop/botkube/pkg/utils"
+)
+
+const (
+	defaultOwnerCacheTTL = 10 * time.Minute
+	// maxOwnerChainDepth protects against cycles in malformed owner references.
+	maxOwnerChainDepth = 10
+)
+
+type ownerCacheEntry struct {
+	owner     *events.Owner
+	expiresAt time.Time
+}
+
+// OwnerResolver resolves the top-level owners of the objects by following the controller owner references,
+// e.g. Pod -> ReplicaSet -> Deployment or Pod -> Job -> CronJob. Resolved owners are cached by the object UID.
+type OwnerResolver struct {
+	log        logrus.FieldLogger
+	dynamicCli dynamic.Interface
+	mapper     meta.RESTMapper
+	cacheTTL   time.Duration
+	nowFn      func() time.Time
+
+	mu          sync.Mutex
+	cache       map[types.UID]ownerCacheEntry
+	lastCleanup time.Time
+}
+
+// NewOwnerResolver creates a new OwnerResolver instance.
+func NewOwnerResolver(log logrus.FieldLogger, dynamicCli dynamic.Interface, mapper meta.RESTMapper, cacheTTL time.Duration) *OwnerResolver {
+	if cacheTTL == 0 {
+		cacheTTL = defaultOwnerCacheTTL
+	}
+	return &OwnerResolver{
+		log:        log,
+		dynamicCli: dynamicCli,
+		mapper:     mapper,
+		cacheTTL:   cacheTTL,
+		nowFn:      time.Now,
+		cache:      map[types.UID]ownerCacheEntry{},
+	}
+}
+
+// TopOwner returns the top-level owner of a given object. For Kubernetes Events, the owner of the involved object is returned.
+// It returns nil if the object doesn't have any owner.
+func (r *OwnerResolver) TopOwner(ctx context.Context, obj interface{}) (*events.Owner, error) {
+	unstrObj, ok := obj.(*unstructured.Unstructured)
+	if !ok {
+		return nil, fmt.Errorf("cannot convert type %T into *unstructured.Unstructured", obj)
+	}
+
+	if unstrObj.GetKind() != "Event" {
+		return r.resolve(ctx, unstrObj.GetUID(), unstrObj.GetNamespace(), unstrObj.GetOwnerReferences())
+	}
+
+	var eventObj coreV1.Event
+	if err := utils.TransformIntoTypedObject(unstrObj, &eventObj); err != nil {
+		return nil, fmt.Errorf("while transforming object type %T into type: %T: %w", obj, eventObj, err)
+	}
+	involved := eventObj.InvolvedObject
+
+	if owner, found := r.cached(involved.UID); found {
+		return owner, nil
+	}
+	involvedObj, err := r.get(ctx, schema.FromAPIVersionAndKind(involved.APIVersion, involved.Kind), involved.Namespace, involved.Name)
+	if err != nil {
+		return nil, err
+	}
+	if involvedObj == nil {
+		return nil, nil
+	}
+	return r.resolve(ctx, involvedObj.GetUID(), involvedObj.GetNamespace(), involvedObj.GetOwnerReferences())
+}
+
+func (r *OwnerResolver) resolve(ctx context.Context, uid types.UID, namespace string, refs []metaV1.OwnerReference) (*events.Owner, error) {
+	if owner, found := r.cached(uid); found {
+		return owner, nil
+	}
+
+	var (
+		owner *events.Owner
+		// intermediate owners share the same top-level owner
+		intermediate []types.UID
+	)
+	for depth := 0; depth < maxOwnerChainDepth; depth++ {
+		ref := controllerRef(refs)
+		if ref == nil {
+			break
+		}
+		owner = &events.Owner{
+			TypeMeta: metaV1.TypeMeta{Kind: ref.Kind, APIVersion: ref.APIVersion},
+			Name:     ref.Name,
+		}
+
+		// chain of the already resolved owner can be reused, e.g. for Pods of the same ReplicaSet
+		if cachedOwner, found := r.cached(ref.UID); found {
+			if cachedOwner != nil {
+				owner = cachedOwner
+			}
+			break
+		}
+
+		ownerObj, err := r.get(ctx, schema.FromAPIVersionAndKind(ref.APIVersion, ref.Kind), namespace, ref.Name)
+		if err != nil {
+			return nil, err
+		}
+		if ownerObj == nil {
+			// owner is already deleted, so the last known one is the top-level owner
+			break
+		}
+		refs = ownerObj.GetOwnerReferences()
+		if controllerRef(refs) != nil {
+			intermediate = append(intermediate, ref.UID)
+		}
+	}
+
+	for _, key := range append(intermediate, uid) {
+		r.store(key, owner)
+	}
+	return owner, nil
+}
+
+// get returns a given object, or nil if the object doesn't exist.
+func (r *OwnerResolver) get(ctx context.Context, gvk schema.GroupVersionKind, namespace, name string) (*unstructured.Unstructured, error) {
+	gvr, err := utils.GetResourceFromKind(r.mapper, gvk)
+	if err != nil {
+		return nil, fmt.Errorf("while getting resource for %q: %w", gvk.String(), err)
+	}
+
+	obj, err := r.dynamicCli.Resource(gvr).Namespace(namespace).Get(ctx, name, metaV1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		r.log.Debugf("%s %s/%s not found", gvk.Kind, namespace, name)
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("while getting %s %s/%s: %w", gvk.Kind, namespace, name, err)
+	}
+	return obj, nil
+}
+
+func (r *OwnerResolver) cached(uid types.UID) (*events.Owner, bool) {
+	if uid == "" {
+		return nil, false
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	entry, found := r.cache[uid]
+	if !found {
+		return nil, false
+	}
+	if r.nowFn().After(entry.expiresAt) {
+		delete(r.cache, uid)
+		return nil, false
+	}
+	return entry.owner, true
+}
+
+func (r *OwnerResolver) store(uid types.UID, owner *events.Owner) {
+	if uid == "" {
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := r.nowFn()
+	// remove expired entries, so the cache doesn't grow with objects which don't exist anymore
+	if now.Sub(r.lastCleanup) > r.cacheTTL {
+		for key, entry := range r.cache {
+			if now.After(entry.expiresAt) {
+				delete(r.cache, key)
+			}
+		}
+		r.lastCleanup = now
+	}
+	r.cache[uid] = ownerCacheEntry{owner: owner, expiresAt: now.Add(r.cacheTTL)}
+}
+
+// controllerRef returns the managing controller reference, or the first reference if there is no controller.
+func controllerRef(refs []metaV1.OwnerReference) *metaV1.OwnerReference {
+	if ref := metaV1.GetControllerOfNoCopy(&metaV1.ObjectMeta{OwnerReferences: refs}); ref != nil {
+		return ref
+	}
+	if len(refs) > 0 {
+		return &refs[0]
+	}
+	return nil
+}
@@ -0,0 +1,174 @@
+package controller
+
+import (
+	"context"
+	"testing"
+
+	logtest "github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsV1 "k8s.io/api/apps/v1"
+	batchV1 "k8s.io/api/batch/v1"
+	coreV1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/dynamic/fake"
+
+	"github.com/kubeshop/botkube/pkg/events"
+)
+
+func TestOwnerResolver_TopOwner(t *testing.T) {
+	// given
+	deploy := &appsV1.Deployment{
+		TypeMeta:   metav1.TypeMeta{Kind: "Deployment", APIVersion: "apps/v1"},
+		ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default", UID: "deploy-uid"},
+	}
+	rs := &appsV1.ReplicaSet{
+		TypeMeta: metav1.TypeMeta{Kind: "ReplicaSet", APIVersion: "apps/v1"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            "web-7f9c",
+			Namespace:       "default",
+			UID:             "rs-uid",
+			OwnerReferences: []metav1.OwnerReference{fixControllerRef("apps/v1", "Deployment", "web", "deploy-uid")},
+		},
+	}
+	pod := fixPodOwnedBy("web-7f9c-xk2", "pod-uid", fixControllerRef("apps/v1", "ReplicaSet", "web-7f9c", "rs-uid"))
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, coreV1.AddToScheme(scheme))
+	require.NoError(t, appsV1.AddToScheme(scheme))
+	require.NoError(t, batchV1.AddToScheme(scheme))
+
+	mapper := meta.NewDefaultRESTMapper(nil)
+	for _, gvk := range []schema.GroupVersionKind{
+		coreV1.SchemeGroupVersion.WithKind("Pod"),
+		appsV1.SchemeGroupVersion.WithKind("ReplicaSet"),
+		appsV1.SchemeGroupVersion.WithKind("Deployment"),
+		batchV1.SchemeGroupVersion.WithKind("Job"),
+	} {
+		mapper.Add(gvk, meta.RESTScopeNamespace)
+	}
+
+	deploymentOwner := &events.Owner{TypeMeta: metav1.TypeMeta{Kind: "Deployment", APIVersion: "apps/v1"}, Name: "web"}
+
+	tests := []struct {
+		name          string
+		givenObject   runtime.Object
+		expectedOwner *events.Owner
+	}{
+		{
+			name:          "Pod created by Deployment",
+			givenObject:   pod,
+			expectedOwner: deploymentOwner,
+		},
+		{
+			name: "Event of Pod created by Deployment",
+			givenObject: &coreV1.Event{
+				TypeMeta:   metav1.TypeMeta{Kind: "Event", APIVersion: "v1"},
+				ObjectMeta: metav1.ObjectMeta{Name: "web-7f9c-xk2.backoff", Namespace: "default"},
+				InvolvedObject: coreV1.ObjectReference{
+					Kind:       "Pod",
+					APIVersion: "v1",
+					Name:       "web-7f9c-xk2",
+					Namespace:  "default",
+				},
+			},
+			expectedOwner: deploymentOwner,
+		},
+		{
+			name:        "Pod created by already deleted Job",
+			givenObject: fixPodOwnedBy("backup-123-abc", "job-pod-uid", fixControllerRef("batch/v1", "Job", "backup-123", "job-uid")),
+			expectedOwner: &events.Owner{
+				TypeMeta: metav1.TypeMeta{Kind: "Job", APIVersion: "batch/v1"},
+				Name:     "backup-123",
+			},
+		},
+		{
+			name:        "Pod without owner",
+			givenObject: fixPodOwnedBy("standalone", "standalone-uid"),
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			dynamicCli := fake.NewSimpleDynamicClient(scheme, deploy, rs, pod)
+			logger, _ := logtest.NewNullLogger()
+			resolver := NewOwnerResolver(logger, dynamicCli, mapper, 0)
+
+			obj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(tc.givenObject)
+			require.NoError(t, err)
+
+			// when
+			owner, err := resolver.TopOwner(context.Background(), &unstructured.Unstructured{Object: obj})
+
+			// then
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedOwner, owner)
+		})
+	}
+}
+
+func TestOwnerResolver_TopOwnerCached(t *testing.T) {
+	// given
+	rsRef := fixControllerRef("apps/v1", "ReplicaSet", "web-7f9c", "rs-uid")
+	rs := &appsV1.ReplicaSet{
+		TypeMeta: metav1.TypeMeta{Kind: "ReplicaSet", APIVersion: "apps/v1"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            "web-7f9c",
+			Namespace:       "default",
+			UID:             "rs-uid",
+			OwnerReferences: []metav1.OwnerReference{fixControllerRef("apps/v1", "Deployment", "web", "deploy-uid")},
+		},
+	}
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, appsV1.AddToScheme(scheme))
+	mapper := meta.NewDefaultRESTMapper(nil)
+	mapper.Add(appsV1.SchemeGroupVersion.WithKind("ReplicaSet"), meta.RESTScopeNamespace)
+	mapper.Add(appsV1.SchemeGroupVersion.WithKind("Deployment"), meta.RESTScopeNamespace)
+
+	dynamicCli := fake.NewSimpleDynamicClient(scheme, rs)
+	logger, _ := logtest.NewNullLogger()
+	resolver := NewOwnerResolver(logger, dynamicCli, mapper, 0)
+
+	// when
+	first, err := resolver.TopOwner(context.Background(), fixUnstructuredPod(t, "web-7f9c-xk2", "first-uid", rsRef))
+	require.NoError(t, err)
+
+	dynamicCli.ClearActions()
+	second, err := resolver.TopOwner(context.Background(), fixUnstructuredPod(t, "web-7f9c-pq8", "second-uid", rsRef))
+	require.NoError(t, err)
+
+	// then
+	assert.Equal(t, "deployment/web", first.String())
+	assert.Equal(t, first, second)
+	assert.Empty(t, dynamicCli.Actions(), "owners of the same ReplicaSet should be resolved from cache")
+}
+
+func fixControllerRef(apiVersion, kind, name string, uid types.UID) metav1.OwnerReference {
+	isController := true
+	return metav1.OwnerReference{APIVersion: apiVersion, Kind: kind, Name: name, UID: uid, Controller: &isController}
+}
+
+func fixPodOwnedBy(name string, uid types.UID, refs ...metav1.OwnerReference) *coreV1.Pod {
+	return &coreV1.Pod{
+		TypeMeta: metav1.TypeMeta{Kind: "Pod", APIVersion: "v1"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            name,
+			Namespace:       "default",
+			UID:             uid,
+			OwnerReferences: refs,
+		},
+	}
+}
+
+func fixUnstructuredPod(t *testing.T, name string, uid types.UID, refs ...metav1.OwnerReference) *unstructured.Unstructured {
+	t.Helper()
+
+	obj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(fixPodOwnedBy(name, uid, refs...))
+	require.NoError(t, err)
+	return &unstructured.Unstructured{Object: obj}
+}
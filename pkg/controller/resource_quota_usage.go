@@ -0,0 +1,157 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	coreV1 "k8s.io/api/core/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+
+	"github.com/kubeshop/botkube/pkg/config"
+	"github.com/kubeshop/botkube/pkg/events"
+	"github.com/kubeshop/botkube/pkg/utils"
+)
+
+const (
+	defaultResourceQuotaCheckInterval    = 5 * time.Minute
+	defaultResourceQuotaThresholdPercent = 90
+)
+
+var resourceQuotaGVR = schema.GroupVersionResource{
+	Version:  "v1",
+	Resource: "resourcequotas",
+}
+
+// ResourceQuotaUsageChecker periodically checks ResourceQuotas and sends events
+// if the used amount of resources crosses the configured percentage of the hard limits.
+type ResourceQuotaUsageChecker struct {
+	log         logrus.FieldLogger
+	dynamicCli  dynamic.Interface
+	cfg         config.ResourceQuotaUsageCheck
+	clusterName string
+	sendFn      sendCheckEventFn
+	nowFn       func() time.Time
+
+	// reported stores issues which are already reported and still persist.
+	reported map[string]struct{}
+}
+
+// NewResourceQuotaUsageChecker creates a new instance of the ResourceQuota Usage Checker.
+func NewResourceQuotaUsageChecker(log logrus.FieldLogger, dynamicCli dynamic.Interface, clusterName string, cfg config.ResourceQuotaUsageCheck, sendFn sendCheckEventFn) *ResourceQuotaUsageChecker {
+	if cfg.Interval == 0 {
+		cfg.Interval = defaultResourceQuotaCheckInterval
+	}
+	if cfg.ThresholdPercent == 0 {
+		cfg.ThresholdPercent = defaultResourceQuotaThresholdPercent
+	}
+
+	return &ResourceQuotaUsageChecker{
+		log:         log,
+		dynamicCli:  dynamicCli,
+		cfg:         cfg,
+		clusterName: clusterName,
+		sendFn:      sendFn,
+		nowFn:       time.Now,
+		reported:    map[string]struct{}{},
+	}
+}
+
+// Run runs the ResourceQuota Usage Checker and checks ResourceQuotas periodically.
+func (c *ResourceQuotaUsageChecker) Run(ctx context.Context) error {
+	c.log.Info("Starting checker")
+	// Check at startup
+	if err := c.check(ctx); err != nil {
+		c.log.Errorf("while checking ResourceQuotas: %s", err.Error())
+	}
+
+	ticker := time.NewTicker(c.cfg.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			c.log.Info("Shutdown requested. Finishing...")
+			return nil
+		case <-ticker.C:
+			if err := c.check(ctx); err != nil {
+				c.log.Errorf("while checking ResourceQuotas: %s", err.Error())
+			}
+		}
+	}
+}
+
+func (c *ResourceQuotaUsageChecker) check(ctx context.Context) error {
+	list, err := c.dynamicCli.Resource(resourceQuotaGVR).List(ctx, metaV1.ListOptions{})
+	if err != nil {
+		return fmt.Errorf("while listing ResourceQuotas: %w", err)
+	}
+
+	// issues which don't persist anymore are removed, so they can be reported again
+	stillReported := map[string]struct{}{}
+	var toSend []pendingEvent
+
+	for i := range list.Items {
+		obj := &list.Items[i]
+		var quota coreV1.ResourceQuota
+		if err := utils.TransformIntoTypedObject(obj, &quota); err != nil {
+			return fmt.Errorf("while transforming object type %T into type: %T: %w", obj, quota, err)
+		}
+
+		resources := make([]string, 0, len(quota.Status.Hard))
+		for name := range quota.Status.Hard {
+			resources = append(resources, string(name))
+		}
+		sort.Strings(resources)
+
+		for _, name := range resources {
+			hard := quota.Status.Hard[coreV1.ResourceName(name)]
+			used, found := quota.Status.Used[coreV1.ResourceName(name)]
+			if !found || hard.IsZero() {
+				continue
+			}
+
+			percent := int(used.AsApproximateFloat64() / hard.AsApproximateFloat64() * 100)
+			if percent < c.cfg.ThresholdPercent {
+				continue
+			}
+
+			key := fmt.Sprintf("%s/%s/%s", quota.Namespace, quota.Name, name)
+			if _, found := c.reported[key]; found {
+				stillReported[key] = struct{}{}
+				continue
+			}
+			toSend = append(toSend, pendingEvent{key: key, obj: obj, event: c.newEvent(quota, fmt.Sprintf(
+				"ResourceQuota '%s/%s' usage of '%s' is %d%% (%s of %s).", quota.Namespace, quota.Name, name, percent, used.String(), hard.String(),
+			))})
+		}
+	}
+
+	c.reported = stillReported
+	sendPendingEvents(ctx, c.sendFn, toSend, c.reported)
+	return nil
+}
+
+func (c *ResourceQuotaUsageChecker) newEvent(quota coreV1.ResourceQuota, msg string) events.Event {
+	resource := utils.GVRToString(resourceQuotaGVR)
+	return events.Event{
+		TypeMeta: metaV1.TypeMeta{
+			Kind:       "ResourceQuota",
+			APIVersion: "v1",
+		},
+		Title:     fmt.Sprintf("%s %s", resource, config.WarningEvent.String()),
+		Name:      quota.Name,
+		Namespace: quota.Namespace,
+		Messages:  []string{msg},
+		Type:      config.WarningEvent,
+		Reason:    "QuotaUsageHigh",
+		Level:     events.LevelMap[config.WarningEvent],
+		Cluster:   c.clusterName,
+		TimeStamp: c.nowFn(),
+		Resource:  resource,
+	}
+}
@@ -0,0 +1,71 @@
+package controller
+
+import (
+	"context"
+	"testing"
+
+	logtest "github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	coreV1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/dynamic/fake"
+
+	"github.com/kubeshop/botkube/pkg/config"
+)
+
+func TestResourceQuotaUsageChecker(t *testing.T) {
+	// given
+	quota := &coreV1.ResourceQuota{
+		TypeMeta:   metav1.TypeMeta{Kind: "ResourceQuota", APIVersion: "v1"},
+		ObjectMeta: metav1.ObjectMeta{Name: "compute", Namespace: "dev"},
+		Status: coreV1.ResourceQuotaStatus{
+			Hard: coreV1.ResourceList{
+				coreV1.ResourceRequestsCPU:    resource.MustParse("4"),
+				coreV1.ResourceRequestsMemory: resource.MustParse("8Gi"),
+				coreV1.ResourcePods:           resource.MustParse("10"),
+			},
+			Used: coreV1.ResourceList{
+				coreV1.ResourceRequestsCPU:    resource.MustParse("3800m"),
+				coreV1.ResourceRequestsMemory: resource.MustParse("2Gi"),
+				coreV1.ResourcePods:           resource.MustParse("10"),
+			},
+		},
+	}
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, coreV1.AddToScheme(scheme))
+	dynamicCli := fake.NewSimpleDynamicClient(scheme, quota)
+
+	fakeSender := &fakeCheckEventSender{skip: true}
+	logger, _ := logtest.NewNullLogger()
+	checker := NewResourceQuotaUsageChecker(logger, dynamicCli, "dev", config.ResourceQuotaUsageCheck{}, fakeSender.send)
+
+	// when
+	err := checker.check(context.Background())
+
+	// then
+	require.NoError(t, err)
+	assert.Empty(t, fakeSender.messages())
+
+	// when
+	fakeSender.skip = false
+	err = checker.check(context.Background())
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"warning: ResourceQuota 'dev/compute' usage of 'pods' is 100% (10 of 10).",
+		"warning: ResourceQuota 'dev/compute' usage of 'requests.cpu' is 95% (3800m of 4).",
+	}, fakeSender.messages())
+
+	// when
+	fakeSender.sentEvents = nil
+	err = checker.check(context.Background())
+
+	// then
+	require.NoError(t, err)
+	assert.Empty(t, fakeSender.messages(), "already reported issues shouldn't be sent again")
+}
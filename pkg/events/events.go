@@ -36,6 +36,43 @@ type Event struct {
 
 	Recommendations []string
 	Warnings        []string
+
+	// Manifest contains the last known YAML manifest of the deleted object, if configured.
+	Manifest string `json:",omitempty"`
+
+	// Owner is the top-level owner of the object, e.g. Deployment for Pods created by a ReplicaSet.
+	Owner *Owner `json:",omitempty"`
+
+	// RoutedChannels contains channels selected by the routing rules. If empty for a given platform,
+	// the Channel or the default channel is used.
+	RoutedChannels config.RoutingChannels `json:"-"`
+
+	// Mentions contains IDs of the users and groups mentioned in the notification per communication platform.
+	// Mentions are formatted by the bot notifiers and ignored by sinks.
+	Mentions map[config.CommPlatformIntegration][]string `json:"-"`
+
+	// AckID is the ID used to acknowledge the critical event, if the acknowledgement workflow is enabled.
+	AckID string `json:",omitempty"`
+
+	// IncidentKey identifies the open incident reported or resolved by the event.
+	// Notifiers use it to post the resolved notification in the thread of the original message.
+	IncidentKey string `json:",omitempty"`
+
+	// RecheckAfter requests running the filters again for the current state of the object after a given time.
+	// Filters use it for issues which are reported only after a grace period, e.g. Service without ready endpoints.
+	RecheckAfter time.Duration `json:"-"`
+}
+
+// Owner describes the top-level owner of the object.
+type Owner struct {
+	metaV1.TypeMeta
+
+	Name string
+}
+
+// String returns the owner in the `kind/name` format, e.g. `deployment/web`.
+func (o Owner) String() string {
+	return fmt.Sprintf("%s/%s", strings.ToLower(o.Kind), o.Name)
 }
 
 // LevelMap is a map of event type to Level
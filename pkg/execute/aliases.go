@@ -0,0 +1,144 @@
+package execute
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/kubeshop/botkube/pkg/config"
+)
+
+const aliasMissingArgsMsg = "Sorry, alias `%s` requires more arguments. Usage: `%s`."
+
+// aliasParamRegex matches the `{param}` placeholders in the aliased commands.
+var aliasParamRegex = regexp.MustCompile(`\{([^{}\s]+)\}`)
+
+// findAlias returns the alias with a given name. The aliases don't override the BotKube commands and kubectl verbs.
+func (e *DefaultExecutor) findAlias(name string) (config.Alias, bool) {
+	alias, found := e.cfg.Aliases[name]
+	if !found || e.resMapping.AllowedKubectlVerbMap[name] || e.commandTree(false).find(name) != nil {
+		return config.Alias{}, false
+	}
+	return alias, true
+}
+
+// aliasNames returns the names of the aliases which don't override the BotKube commands and kubectl verbs.
+func (e *DefaultExecutor) aliasNames() []string {
+	var out []string
+	for name := range e.cfg.Aliases {
+		if _, found := e.findAlias(name); found {
+			out = append(out, name)
+		}
+	}
+	return out
+}
+
+// expandAlias returns the aliased command for given alias arguments. The `{param}` placeholders are filled with the arguments
+// in the order of their first appearance, and the remaining arguments are appended to the command.
+func expandAlias(name string, alias config.Alias, args []string) ([]string, error) {
+	tokens, err := aliasTokens(alias)
+	if err != nil {
+		return nil, fmt.Errorf("while parsing alias %q: %w", name, err)
+	}
+
+	params := aliasParams(alias)
+	if len(args) < len(params) {
+		return nil, fmt.Errorf(aliasMissingArgsMsg, name, aliasUsage(name, alias))
+	}
+	values := map[string]string{}
+	for i, param := range params {
+		values[param] = args[i]
+	}
+
+	var out []string
+	for _, token := range tokens {
+		out = append(out, aliasParamRegex.ReplaceAllStringFunc(token, func(placeholder string) string {
+			return values[strings.Trim(placeholder, "{}")]
+		}))
+	}
+	return append(out, args[len(params):]...), nil
+}
+
+// aliasTokens returns the aliased command without the optional `kubectl` prefix.
+func aliasTokens(alias config.Alias) ([]string, error) {
+	tokens, err := tokenize(alias.Command)
+	if err != nil {
+		return nil, err
+	}
+	if len(tokens) > 0 && tokens[0] == "kubectl" {
+		tokens = tokens[1:]
+	}
+	if len(tokens) == 0 {
+		return nil, fmt.Errorf("empty command")
+	}
+	return tokens, nil
+}
+
+// aliasParams returns the names of the alias parameters in the order of their first appearance.
+func aliasParams(alias config.Alias) []string {
+	var (
+		out  []string
+		seen = map[string]bool{}
+	)
+	for _, match := range aliasParamRegex.FindAllStringSubmatch(alias.Command, -1) {
+		if seen[match[1]] {
+			continue
+		}
+		seen[match[1]] = true
+		out = append(out, match[1])
+	}
+	return out
+}
+
+// aliasUsage returns the usage of a given alias, e.g. `restart <name>`.
+func aliasUsage(name string, alias config.Alias) string {
+	parts := []string{name}
+	for _, param := range aliasParams(alias) {
+		parts = append(parts, fmt.Sprintf("<%s>", param))
+	}
+	return strings.Join(parts, " ")
+}
+
+// allowedAliases returns the usage and command of the aliases for given allowed kubectl verbs and the BotKube commands.
+func (e *DefaultExecutor) allowedAliases(verbs map[string]bool) map[string]string {
+	root := e.commandTree(true)
+	out := map[string]string{}
+	for name := range e.cfg.Aliases {
+		alias, found := e.findAlias(name)
+		if !found {
+			continue
+		}
+		tokens, err := aliasTokens(alias)
+		if err != nil || (!verbs[tokens[0]] && root.find(tokens[0]) == nil) {
+			continue
+		}
+
+		desc := alias.Command
+		if alias.Description != "" {
+			desc = fmt.Sprintf("%s (%s)", alias.Command, alias.Description)
+		}
+		out[aliasUsage(name, alias)] = desc
+	}
+	return out
+}
+
+// aliasesList returns the list of given aliases, or empty string if there are none.
+func aliasesList(aliases map[string]string) string {
+	if len(aliases) == 0 {
+		return ""
+	}
+
+	var usages []string
+	for usage := range aliases {
+		usages = append(usages, usage)
+	}
+	sort.Strings(usages)
+
+	var out strings.Builder
+	out.WriteString("aliases:\n")
+	for _, usage := range usages {
+		fmt.Fprintf(&out, "  - %s: %s\n", usage, aliases[usage])
+	}
+	return out.String()
+}
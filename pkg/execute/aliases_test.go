@@ -0,0 +1,118 @@
+package execute
+
+import (
+	"context"
+	"testing"
+
+	logtest "github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kubeshop/botkube/pkg/config"
+)
+
+func TestDefaultExecutor_Aliases(t *testing.T) {
+	cfg := config.Config{
+		Settings: config.Settings{ClusterName: "dev"},
+		Executors: config.IndexableMap[config.Executors]{
+			"kubectl": {Kubectl: config.Kubectl{
+				Enabled: true,
+				Commands: config.Commands{
+					Verbs:     []string{"get", "rollout"},
+					Resources: []string{"pods", "deployments"},
+				},
+			}},
+		},
+		Aliases: map[string]config.Alias{
+			"pods":    {Command: "kubectl get pods -o wide"},
+			"restart": {Command: "kubectl rollout restart deployment/{name} -n {namespace}", Description: "Restarts a given Deployment."},
+			"secrets": {Command: "kubectl get secrets"},
+			"get":     {Command: "kubectl get pods"},
+		},
+	}
+	resMapping := ResourceMapping{
+		KindResourceMap:           map[string]string{"deployment": "deployments"},
+		AllowedKubectlResourceMap: map[string]bool{"pods": true, "deployments": true},
+		AllowedKubectlVerbMap:     map[string]bool{"get": true, "rollout": true},
+	}
+
+	testCases := []struct {
+		Name               string
+		Message            string
+		ExpectedOutput     string
+		ExpectedKubectlCmd []string
+	}{
+		{
+			Name:               "Alias",
+			Message:            "pods",
+			ExpectedOutput:     "Cluster: dev\nout",
+			ExpectedKubectlCmd: []string{"get", "pods", "-o", "wide"},
+		},
+		{
+			Name:               "Alias with additional arguments",
+			Message:            "pods -n team-a",
+			ExpectedOutput:     "Cluster: dev\nout",
+			ExpectedKubectlCmd: []string{"get", "pods", "-o", "wide", "-n", "team-a"},
+		},
+		{
+			Name:               "Macro with parameters",
+			Message:            "restart api team-a",
+			ExpectedOutput:     "Cluster: dev\nout",
+			ExpectedKubectlCmd: []string{"rollout", "restart", "deployment/api", "-n", "team-a"},
+		},
+		{
+			Name:           "Macro with missing parameters",
+			Message:        "restart api",
+			ExpectedOutput: "Sorry, alias `restart` requires more arguments. Usage: `restart <name> <namespace>`.",
+		},
+		{
+			Name:           "Alias for not allowed resource",
+			Message:        "secrets",
+			ExpectedOutput: "Command not supported. Please run `@BotKube help` to see supported commands.",
+		},
+		{
+			Name:               "Alias doesn't override kubectl verb",
+			Message:            "get deployments",
+			ExpectedOutput:     "Cluster: dev\nout",
+			ExpectedKubectlCmd: []string{"get", "deployments"},
+		},
+		{
+			Name:           "Mistyped alias",
+			Message:        "restrat",
+			ExpectedOutput: "Command not supported. Did you mean `restart`? Please run `@BotKube help` to see supported commands.",
+		},
+		{
+			Name:    "Aliases in allowed commands",
+			Message: "commands list",
+			ExpectedOutput: "allowed verbs:\n  - get\n  - rollout\n" +
+				"allowed resources:\n  - deployments\n  - pods\n" +
+				"aliases:\n" +
+				"  - pods: kubectl get pods -o wide\n" +
+				"  - restart <name> <namespace>: kubectl rollout restart deployment/{name} -n {namespace} (Restarts a given Deployment.)\n" +
+				"  - secrets: kubectl get secrets\n",
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// given
+			var kubectlCmd []string
+			runCmdFn := func(_ context.Context, args []string) (string, error) {
+				kubectlCmd = args
+				return "out", nil
+			}
+			logger, _ := logtest.NewNullLogger()
+			factory := NewExecutorFactory(logger, runCmdFn, nil, cfg, nil, nil, resMapping, &fakeAnalyticsReporter{}, nil)
+
+			// when
+			out := factory.NewDefault(NewDefaultInput{
+				Platform:         config.SlackCommPlatformIntegration,
+				IsAuthChannel:    true,
+				ExecutorBindings: []string{"kubectl"},
+				Message:          testCase.Message,
+			}).Execute()
+
+			// then
+			assert.Equal(t, testCase.ExpectedOutput, out)
+			assert.Equal(t, testCase.ExpectedKubectlCmd, kubectlCmd)
+		})
+	}
+}
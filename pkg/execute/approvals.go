@@ -0,0 +1,297 @@
+package execute
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/kubeshop/botkube/pkg/audit"
+	"github.com/kubeshop/botkube/pkg/config"
+	"github.com/kubeshop/botkube/pkg/utils"
+)
+
+const (
+	confirmationRequiredMsg = "Command `%s` requires the confirmation on cluster '%s'. Run `approve %s` to run it, or `reject %s` to drop it. The request expires in %s."
+	approvalRequiredMsg     = "Command `%s` requires the approval of another user on cluster '%s'. Run `approve %s` to run it, or `reject %s` to drop it. The request expires in %s."
+	approvalIDMissingMsg    = "You forgot to pass the request ID, e.g. `%s a1b2c3`."
+	approvalNotFoundMsg     = "There is no pending command request with ID '%s' on cluster '%s'."
+	approvalExpiredMsg      = "Sorry, the command request '%s' on cluster '%s' has expired."
+	confirmationDeniedMsg   = "Sorry, only the user who sent the command request '%s' can confirm it."
+	selfApprovalDeniedMsg   = "Sorry, the command request '%s' must be approved by another user."
+	approvalRejectedMsg     = "Done. Command request '%s' (`%s`) on cluster '%s' has been rejected."
+
+	defaultApprovalTimeout = 10 * time.Minute
+	idBytesLength          = 3
+	maxIDGenerateTries     = 10
+)
+
+var (
+	errApprovalNotFound = errors.New("command request not found")
+	errApprovalExpired  = errors.New("command request expired")
+)
+
+// Action is an interactive element, e.g. a button, which runs a given command when used.
+type Action struct {
+	Name    string
+	Command string
+}
+
+// pendingCommand is a kubectl command waiting for the confirmation or approval.
+type pendingCommand struct {
+	ID string
+	// Command is the command sent by the user, used in the messages.
+	Command string
+	// Args are the final kubectl arguments, including the impersonation flags of the requester.
+	Args        []string
+	Verb        string
+	Streaming   bool
+	Requester   User
+	Platform    config.CommPlatformIntegration
+	Requirement config.CommandPolicyRequirement
+	ExpiresAt   time.Time
+	// Kubectl is the parsed command, checked against the executors bound to the channel in which the command is approved.
+	Kubectl kubectlCommand
+}
+
+// approvalTracker stores the kubectl commands waiting for the confirmation or approval.
+// IDs are random, so the requests don't collide between BotKube instances running in the same channel.
+type approvalTracker struct {
+	nowFn func() time.Time
+	idFn  func() (string, error)
+
+	mu      sync.Mutex
+	pending map[string]*pendingCommand
+}
+
+func newApprovalTracker() *approvalTracker {
+	return &approvalTracker{
+		nowFn:   time.Now,
+		idFn:    randomID,
+		pending: map[string]*pendingCommand{},
+	}
+}
+
+// add stores a given command until the timeout passes and returns its ID.
+func (t *approvalTracker) add(cmd pendingCommand, timeout time.Duration) (pendingCommand, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	now := t.nowFn()
+	for id, p := range t.pending {
+		if !now.Before(p.ExpiresAt) {
+			delete(t.pending, id)
+		}
+	}
+
+	id, err := t.newID()
+	if err != nil {
+		return pendingCommand{}, fmt.Errorf("while generating ID: %w", err)
+	}
+	cmd.ID = id
+	cmd.ExpiresAt = now.Add(timeout)
+	t.pending[id] = &cmd
+	return cmd, nil
+}
+
+// take removes and returns the pending command with a given ID if the check passes.
+// It returns false if there is no such command, and errApprovalExpired if it has expired.
+func (t *approvalTracker) take(id string, check func(cmd pendingCommand) error) (pendingCommand, bool, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	cmd, found := t.pending[id]
+	if !found {
+		return pendingCommand{}, false, nil
+	}
+	if !t.nowFn().Before(cmd.ExpiresAt) {
+		delete(t.pending, id)
+		return *cmd, true, errApprovalExpired
+	}
+	if err := check(*cmd); err != nil {
+		return *cmd, true, err
+	}
+	delete(t.pending, id)
+	return *cmd, true, nil
+}
+
+// newID returns a unique ID. Must be called with the lock held.
+func (t *approvalTracker) newID() (string, error) {
+	for i := 0; i < maxIDGenerateTries; i++ {
+		id, err := t.idFn()
+		if err != nil {
+			return "", err
+		}
+		if _, exists := t.pending[id]; !exists {
+			return id, nil
+		}
+	}
+	return "", fmt.Errorf("cannot generate unique ID in %d tries", maxIDGenerateTries)
+}
+
+// randomID returns a short random ID of the command requests and jobs.
+func randomID() (string, error) {
+	b := make([]byte, idBytesLength)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// requirementFor returns the strictest requirement of the executor policies matching a given command.
+// It returns false if none of the policies matches.
+func (p kubectlProfile) requirementFor(cmd kubectlCommand) (config.CommandPolicyRequirement, bool) {
+	var (
+		out     config.CommandPolicyRequirement
+		matched bool
+	)
+	for _, policy := range p.Policies {
+		if !policyMatches(policy, cmd) {
+			continue
+		}
+		matched = true
+		if policy.Require != config.ConfirmationRequirement {
+			// the approval is stricter, so it wins over the confirmation
+			return config.ApprovalRequirement, true
+		}
+		out = config.ConfirmationRequirement
+	}
+	return out, matched
+}
+
+// policyMatches returns true if a given command is matched by the policy.
+// The commands with unknown resources, e.g. `drain`, are matched only by the policies without resources.
+func policyMatches(policy config.CommandPolicy, cmd kubectlCommand) bool {
+	if !utils.Contains(policy.Verbs, cmd.Verb) && !utils.Contains(policy.Verbs, allVerbs) {
+		return false
+	}
+	if len(policy.Resources) == 0 {
+		return true
+	}
+	for _, resource := range cmd.Resources {
+		if utils.Contains(policy.Resources, resource) {
+			return true
+		}
+	}
+	return false
+}
+
+// requestApproval stores the kubectl command until it's approved and returns the message with instructions.
+func (e *DefaultExecutor) requestApproval(cmd pendingCommand) string {
+	clusterName := e.cfg.Settings.ClusterName
+	timeout := e.cfg.Settings.Execution.ApprovalTimeout
+	if timeout == 0 {
+		timeout = defaultApprovalTimeout
+	}
+	pending, err := e.approvals.add(cmd, timeout)
+	if err != nil {
+		e.log.Errorf("while storing command request: %s", err.Error())
+		e.setAuditResult(audit.StatusFailed, err.Error())
+		return fmt.Sprintf("Cluster: %s\n%s", clusterName, err.Error())
+	}
+	e.log.Infof("Command %q requires %s, request ID %s", pending.Command, pending.Requirement, pending.ID)
+	e.setAuditResult(audit.StatusPendingApproval, "")
+
+	e.actions = []Action{
+		{Name: "Approve", Command: fmt.Sprintf("approve %s", pending.ID)},
+		{Name: "Reject", Command: fmt.Sprintf("reject %s", pending.ID)},
+	}
+	msg := approvalRequiredMsg
+	if pending.Requirement == config.ConfirmationRequirement {
+		msg = confirmationRequiredMsg
+	}
+	return fmt.Sprintf(msg, pending.Command, clusterName, pending.ID, pending.ID, timeout)
+}
+
+// runApproveCommand runs the pending kubectl command if the current user can confirm or approve it.
+// The command needs to be allowed also by the executors bound to the channel in which it's approved.
+func (e *DefaultExecutor) runApproveCommand(cmd parsedCommand) string {
+	if len(cmd.Args) == 0 {
+		return fmt.Sprintf(approvalIDMissingMsg, cmd.Command.Name)
+	}
+
+	id := cmd.Args[0]
+	pending, found, err := e.approvals.take(id, func(pending pendingCommand) error {
+		if pending.Platform != e.Platform {
+			return errApprovalNotFound
+		}
+		if pending.Requirement == config.ConfirmationRequirement {
+			if e.User.ID != pending.Requester.ID {
+				return fmt.Errorf(confirmationDeniedMsg, id)
+			}
+		} else {
+			if e.User.ID == "" || e.User.ID == pending.Requester.ID {
+				return fmt.Errorf(selfApprovalDeniedMsg, id)
+			}
+			if !e.isUserAllowed(pending.Verb) {
+				return fmt.Errorf(userNotAllowedMsg, pending.Verb, e.cfg.Settings.ClusterName)
+			}
+		}
+		if _, err := e.authorizeKubectlCommand(pending.Kubectl); err != nil {
+			e.log.Infof("Refusing to approve kubectl %s command: missing %s", pending.Verb, err.Error())
+			return errors.New(e.kubectlPermissionDeniedMsg(err))
+		}
+		return nil
+	})
+	if msg, done := e.approvalErrorMsg(id, found, err); done {
+		return msg
+	}
+
+	e.log.Infof("Running command %q approved by %q", pending.Command, e.User.ID)
+	e.auditEvent.Command = pending.Command
+	e.auditEvent.RequestedBy = pending.Requester.ID
+	args, isStreaming := pending.Args, pending.Streaming
+	if isStreaming && !e.canStreamOutput() {
+		args, isStreaming = withoutStreamingFlags(args), false
+	}
+	return e.runKubectlJob(pending.Verb, pending.Command, args, isStreaming)
+}
+
+// runRejectCommand drops the pending kubectl command. It can be rejected by the requester or by the users allowed to run it.
+func (e *DefaultExecutor) runRejectCommand(cmd parsedCommand) string {
+	if len(cmd.Args) == 0 {
+		return fmt.Sprintf(approvalIDMissingMsg, cmd.Command.Name)
+	}
+
+	id := cmd.Args[0]
+	pending, found, err := e.approvals.take(id, func(pending pendingCommand) error {
+		if pending.Platform != e.Platform {
+			return errApprovalNotFound
+		}
+		if e.User.ID != pending.Requester.ID && !e.isUserAllowed(pending.Verb) {
+			return fmt.Errorf(userNotAllowedMsg, pending.Verb, e.cfg.Settings.ClusterName)
+		}
+		return nil
+	})
+	if msg, done := e.approvalErrorMsg(id, found, err); done {
+		return msg
+	}
+
+	e.log.Infof("Command %q rejected by %q", pending.Command, e.User.ID)
+	e.auditEvent.RequestedBy = pending.Requester.ID
+	return fmt.Sprintf(approvalRejectedMsg, id, pending.Command, e.cfg.Settings.ClusterName)
+}
+
+// approvalErrorMsg returns the message for a failed attempt to take the pending command. It returns false if there is no error.
+func (e *DefaultExecutor) approvalErrorMsg(id string, found bool, err error) (string, bool) {
+	clusterName := e.cfg.Settings.ClusterName
+	switch {
+	case !found || errors.Is(err, errApprovalNotFound):
+		e.setAuditResult(audit.StatusFailed, errApprovalNotFound.Error())
+		return fmt.Sprintf(approvalNotFoundMsg, id, clusterName), true
+	case errors.Is(err, errApprovalExpired):
+		e.setAuditResult(audit.StatusFailed, err.Error())
+		return fmt.Sprintf(approvalExpiredMsg, id, clusterName), true
+	case err != nil:
+		e.setAuditResult(audit.StatusDenied, err.Error())
+		return err.Error(), true
+	}
+	return "", false
+}
+
+// Actions returns the interactive elements, e.g. buttons, for the last response. They are supported only on some platforms.
+func (e *DefaultExecutor) Actions() []Action {
+	return e.actions
+}
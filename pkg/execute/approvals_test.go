@@ -0,0 +1,340 @@
+package execute
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	logtest "github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kubeshop/botkube/pkg/config"
+)
+
+func TestDefaultExecutor_CommandApproval(t *testing.T) {
+	resMapping := ResourceMapping{
+		AllowedKubectlResourceMap: map[string]bool{"pods": true},
+		AllowedKubectlVerbMap:     map[string]bool{"logs": true},
+	}
+	const (
+		requester = "U001"
+		approver  = "U002"
+	)
+
+	testCases := []struct {
+		Name               string
+		Requirement        config.CommandPolicyRequirement
+		Reply              string
+		ReplyUser          string
+		Expire             bool
+		ExpectedRequestMsg string
+		ExpectedReplyMsg   string
+		ExpectedRun        bool
+	}{
+		{
+			Name:               "Confirmed by requester",
+			Requirement:        config.ConfirmationRequirement,
+			Reply:              "approve a1b2c3",
+			ReplyUser:          requester,
+			ExpectedRequestMsg: "Command `logs my-pod` requires the confirmation on cluster 'prod'. Run `approve a1b2c3` to run it, or `reject a1b2c3` to drop it. The request expires in 10m0s.",
+			ExpectedReplyMsg:   "Cluster: prod\nline 1",
+			ExpectedRun:        true,
+		},
+		{
+			Name:               "Confirmation by another user refused",
+			Requirement:        config.ConfirmationRequirement,
+			Reply:              "approve a1b2c3",
+			ReplyUser:          approver,
+			ExpectedRequestMsg: "Command `logs my-pod` requires the confirmation on cluster 'prod'. Run `approve a1b2c3` to run it, or `reject a1b2c3` to drop it. The request expires in 10m0s.",
+			ExpectedReplyMsg:   "Sorry, only the user who sent the command request 'a1b2c3' can confirm it.",
+		},
+		{
+			Name:               "Approved by another user",
+			Requirement:        config.ApprovalRequirement,
+			Reply:              "approve a1b2c3",
+			ReplyUser:          approver,
+			ExpectedRequestMsg: "Command `logs my-pod` requires the approval of another user on cluster 'prod'. Run `approve a1b2c3` to run it, or `reject a1b2c3` to drop it. The request expires in 10m0s.",
+			ExpectedReplyMsg:   "Cluster: prod\nline 1",
+			ExpectedRun:        true,
+		},
+		{
+			Name:               "Self-approval refused",
+			Requirement:        config.ApprovalRequirement,
+			Reply:              "approve a1b2c3",
+			ReplyUser:          requester,
+			ExpectedRequestMsg: "Command `logs my-pod` requires the approval of another user on cluster 'prod'. Run `approve a1b2c3` to run it, or `reject a1b2c3` to drop it. The request expires in 10m0s.",
+			ExpectedReplyMsg:   "Sorry, the command request 'a1b2c3' must be approved by another user.",
+		},
+		{
+			Name:               "Expired request",
+			Requirement:        config.ApprovalRequirement,
+			Reply:              "approve a1b2c3",
+			ReplyUser:          approver,
+			Expire:             true,
+			ExpectedRequestMsg: "Command `logs my-pod` requires the approval of another user on cluster 'prod'. Run `approve a1b2c3` to run it, or `reject a1b2c3` to drop it. The request expires in 10m0s.",
+			ExpectedReplyMsg:   "Sorry, the command request 'a1b2c3' on cluster 'prod' has expired.",
+		},
+		{
+			Name:               "Rejected request",
+			Requirement:        config.ApprovalRequirement,
+			Reply:              "reject a1b2c3",
+			ReplyUser:          approver,
+			ExpectedRequestMsg: "Command `logs my-pod` requires the approval of another user on cluster 'prod'. Run `approve a1b2c3` to run it, or `reject a1b2c3` to drop it. The request expires in 10m0s.",
+			ExpectedReplyMsg:   "Done. Command request 'a1b2c3' (`logs my-pod`) on cluster 'prod' has been rejected.",
+		},
+		{
+			Name:               "Unknown request",
+			Requirement:        config.ApprovalRequirement,
+			Reply:              "approve d4e5f6",
+			ReplyUser:          approver,
+			ExpectedRequestMsg: "Command `logs my-pod` requires the approval of another user on cluster 'prod'. Run `approve a1b2c3` to run it, or `reject a1b2c3` to drop it. The request expires in 10m0s.",
+			ExpectedReplyMsg:   "There is no pending command request with ID 'd4e5f6' on cluster 'prod'.",
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// given
+			cfg := newJobsTestConfig(0)
+			kubectl := cfg.Executors["kubectl"]
+			kubectl.Kubectl.Policies = []config.CommandPolicy{
+				{Verbs: []string{"logs"}, Resources: []string{"pods"}, Require: config.ApprovalRequirement},
+				{Verbs: []string{"logs"}, Require: testCase.Requirement},
+			}
+			cfg.Executors["kubectl"] = kubectl
+
+			var kubectlCmd []string
+			runCmdFn := func(_ context.Context, args []string) (string, error) {
+				kubectlCmd = args
+				return "line 1", nil
+			}
+			logger, _ := logtest.NewNullLogger()
+			factory := NewExecutorFactory(logger, runCmdFn, nil, cfg, nil, nil, resMapping, &fakeAnalyticsReporter{}, nil)
+			now := time.Now()
+			factory.approvals.nowFn = func() time.Time { return now }
+			factory.approvals.idFn = func() (string, error) { return "a1b2c3", nil }
+
+			request := newApprovalsTestExecutor(factory, requester, "logs my-pod")
+			requestMsg := request.Execute()
+			require.Equal(t, testCase.ExpectedRequestMsg, requestMsg)
+			assert.Equal(t, []Action{
+				{Name: "Approve", Command: "approve a1b2c3"},
+				{Name: "Reject", Command: "reject a1b2c3"},
+			}, request.Actions())
+			require.Nil(t, kubectlCmd)
+
+			if testCase.Expire {
+				now = now.Add(defaultApprovalTimeout)
+			}
+
+			// when
+			out := newApprovalsTestExecutor(factory, testCase.ReplyUser, testCase.Reply).Execute()
+
+			// then
+			assert.Equal(t, testCase.ExpectedReplyMsg, out)
+			if testCase.ExpectedRun {
+				assert.Equal(t, []string{"logs", "my-pod"}, kubectlCmd)
+			} else {
+				assert.Nil(t, kubectlCmd)
+			}
+		})
+	}
+}
+
+func TestDefaultExecutor_CommandApprovalChannelPermissions(t *testing.T) {
+	// given
+	resMapping := ResourceMapping{
+		AllowedKubectlResourceMap: map[string]bool{"pods": true},
+		AllowedKubectlVerbMap:     map[string]bool{"logs": true},
+	}
+	cfg := newJobsTestConfig(0)
+	kubectl := cfg.Executors["kubectl"]
+	kubectl.Kubectl.Policies = []config.CommandPolicy{
+		{Verbs: []string{"logs"}, Require: config.ApprovalRequirement},
+	}
+	cfg.Executors["kubectl"] = kubectl
+	cfg.Executors["kubectl-dev"] = config.Executors{Kubectl: config.Kubectl{
+		Enabled:    true,
+		Namespaces: config.Namespaces{Include: []string{"dev"}},
+		Commands: config.Commands{
+			Verbs:     []string{"logs"},
+			Resources: []string{"pods"},
+		},
+	}}
+
+	var kubectlCmd []string
+	runCmdFn := func(_ context.Context, args []string) (string, error) {
+		kubectlCmd = args
+		return "line 1", nil
+	}
+	logger, _ := logtest.NewNullLogger()
+	factory := NewExecutorFactory(logger, runCmdFn, nil, cfg, nil, nil, resMapping, &fakeAnalyticsReporter{}, nil)
+	factory.approvals.idFn = func() (string, error) { return "a1b2c3", nil }
+	approve := func(isAuthChannel bool, bindings []string) string {
+		return factory.NewDefault(NewDefaultInput{
+			Platform:         config.SlackCommPlatformIntegration,
+			IsAuthChannel:    isAuthChannel,
+			ExecutorBindings: bindings,
+			User:             User{ID: "U002"},
+			Message:          "approve a1b2c3",
+		}).Execute()
+	}
+
+	requestMsg := newApprovalsTestExecutor(factory, "U001", "logs my-pod -n default").Execute()
+	require.Contains(t, requestMsg, "requires the approval of another user")
+
+	// when
+	otherChannel := approve(false, nil)
+	devChannel := approve(true, []string{"kubectl-dev"})
+
+	// then
+	assert.Empty(t, otherChannel)
+	assert.Equal(t, "Sorry, this channel doesn't have permission to run this command on cluster 'prod'. Missing permission: namespace `default`.", devChannel)
+	assert.Nil(t, kubectlCmd, "command shouldn't be run without the permissions of the approver's channel")
+
+	// when
+	approved := approve(true, []string{"kubectl"})
+
+	// then
+	assert.Equal(t, "Cluster: prod\nline 1", approved)
+	assert.Equal(t, []string{"logs", "my-pod", "-n", "default"}, kubectlCmd)
+}
+
+func TestDefaultExecutor_CommandPolicyMultipleResources(t *testing.T) {
+	resMapping := ResourceMapping{
+		AllowedKubectlResourceMap: map[string]bool{"pods": true, "secrets": true},
+		AllowedKubectlVerbMap:     map[string]bool{"get": true},
+	}
+	cfg := newJobsTestConfig(0)
+	cfg.Executors["kubectl"] = config.Executors{Kubectl: config.Kubectl{
+		Enabled: true,
+		Commands: config.Commands{
+			Verbs:     []string{"get"},
+			Resources: []string{"pods", "secrets"},
+		},
+		Policies: []config.CommandPolicy{
+			{Verbs: []string{"get"}, Resources: []string{"secrets"}, Require: config.ApprovalRequirement},
+		},
+	}}
+
+	testCases := []struct {
+		Name             string
+		Message          string
+		ExpectedApproval bool
+	}{
+		{
+			Name:    "Resource without policy",
+			Message: "get pods",
+		},
+		{
+			Name:             "Resource with policy in comma-separated list",
+			Message:          "get pods,secrets",
+			ExpectedApproval: true,
+		},
+		{
+			Name:             "Resource with policy in type/name arguments",
+			Message:          "get pods/app secrets/token",
+			ExpectedApproval: true,
+		},
+		{
+			Name:             "Resource with policy and combined shorthand flags",
+			Message:          "get -Rnprod pods secrets/token",
+			ExpectedApproval: true,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// given
+			var kubectlCmd []string
+			runCmdFn := func(_ context.Context, args []string) (string, error) {
+				kubectlCmd = args
+				return "out", nil
+			}
+			logger, _ := logtest.NewNullLogger()
+			factory := NewExecutorFactory(logger, runCmdFn, nil, cfg, nil, nil, resMapping, &fakeAnalyticsReporter{}, nil)
+			factory.approvals.idFn = func() (string, error) { return "a1b2c3", nil }
+
+			// when
+			out := newApprovalsTestExecutor(factory, "U001", testCase.Message).Execute()
+
+			// then
+			if testCase.ExpectedApproval {
+				assert.Contains(t, out, "requires the approval of another user")
+				assert.Nil(t, kubectlCmd)
+				return
+			}
+			assert.Equal(t, "Cluster: prod\nout", out)
+			assert.NotNil(t, kubectlCmd)
+		})
+	}
+}
+
+func TestKubectlProfile_RequirementFor(t *testing.T) {
+	profile := kubectlProfile{Kubectl: config.Kubectl{
+		Policies: []config.CommandPolicy{
+			{Verbs: []string{"cordon", "drain"}, Require: config.ConfirmationRequirement},
+			{Verbs: []string{"delete"}, Resources: []string{"pods"}, Require: config.ConfirmationRequirement},
+			{Verbs: []string{"*"}, Resources: []string{"deployments"}, Require: config.ApprovalRequirement},
+		},
+	}}
+
+	testCases := []struct {
+		Name                string
+		Command             kubectlCommand
+		ExpectedRequirement config.CommandPolicyRequirement
+		ExpectedFound       bool
+	}{
+		{
+			Name:                "Verb without resources",
+			Command:             kubectlCommand{Verb: "drain"},
+			ExpectedRequirement: config.ConfirmationRequirement,
+			ExpectedFound:       true,
+		},
+		{
+			Name:                "Verb and resource",
+			Command:             kubectlCommand{Verb: "delete", Resources: []string{"pods"}},
+			ExpectedRequirement: config.ConfirmationRequirement,
+			ExpectedFound:       true,
+		},
+		{
+			Name:                "Approval wins over confirmation",
+			Command:             kubectlCommand{Verb: "delete", Resources: []string{"pods", "deployments"}},
+			ExpectedRequirement: config.ApprovalRequirement,
+			ExpectedFound:       true,
+		},
+		{
+			Name:    "Other resource",
+			Command: kubectlCommand{Verb: "delete", Resources: []string{"services"}},
+		},
+		{
+			Name:                "Resource with policy among other resources",
+			Command:             kubectlCommand{Verb: "delete", Resources: []string{"services", "pods"}},
+			ExpectedRequirement: config.ConfirmationRequirement,
+			ExpectedFound:       true,
+		},
+		{
+			Name:    "Unknown resources",
+			Command: kubectlCommand{Verb: "logs"},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// when
+			requirement, found := profile.requirementFor(testCase.Command)
+
+			// then
+			assert.Equal(t, testCase.ExpectedFound, found)
+			assert.Equal(t, testCase.ExpectedRequirement, requirement)
+		})
+	}
+}
+
+func newApprovalsTestExecutor(factory *DefaultExecutorFactory, userID, msg string) Executor {
+	return factory.NewDefault(NewDefaultInput{
+		Platform:         config.SlackCommPlatformIntegration,
+		IsAuthChannel:    true,
+		ExecutorBindings: []string{"kubectl"},
+		User:             User{ID: userID},
+		Message:          msg,
+	})
+}
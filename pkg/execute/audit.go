@@ -0,0 +1,51 @@
+package execute
+
+import (
+	"time"
+
+	"github.com/kubeshop/botkube/pkg/audit"
+	"github.com/kubeshop/botkube/pkg/utils"
+)
+
+// setAuditResult sets the result of the command reported in the audit record.
+func (e *DefaultExecutor) setAuditResult(status audit.Status, reason string) {
+	e.auditEvent.Status = status
+	e.auditEvent.Error = reason
+}
+
+// auditCommand records the command which started at a given time, together with its result.
+func (e *DefaultExecutor) auditCommand(start time.Time) {
+	if e.auditor == nil {
+		return
+	}
+	e.auditor.AuditCommand(e.auditRecord(e.auditEvent, start))
+}
+
+// auditStreamingSessionEnd records the final result of the streaming session which started at a given time.
+// The session is recorded also when it starts, with the started status.
+func (e *DefaultExecutor) auditStreamingSessionEnd(event audit.Event, start time.Time, status audit.Status, reason string) {
+	if e.auditor == nil {
+		return
+	}
+	event.Status = status
+	event.Error = reason
+	e.auditor.AuditCommand(e.auditRecord(event, start))
+}
+
+// auditRecord completes a given audit record of the command which started at a given time.
+func (e *DefaultExecutor) auditRecord(event audit.Event, start time.Time) audit.Event {
+	if event.Command == "" {
+		event.Command = utils.RemoveHyperlink(e.Message)
+	}
+	if event.Status == "" {
+		event.Status = audit.StatusSucceeded
+	}
+	event.Timestamp = start
+	event.Cluster = e.cfg.Settings.ClusterName
+	event.Platform = e.Platform
+	event.Channel = e.Channel
+	event.User = e.User.ID
+	event.Allowed = event.Status != audit.StatusDenied
+	event.DurationMs = time.Since(start).Milliseconds()
+	return event
+}
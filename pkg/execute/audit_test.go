@@ -0,0 +1,204 @@
+package execute
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	logtest "github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kubeshop/botkube/pkg/audit"
+	"github.com/kubeshop/botkube/pkg/config"
+)
+
+func TestDefaultExecutor_AuditCommand(t *testing.T) {
+	resMapping := ResourceMapping{
+		AllowedKubectlResourceMap: map[string]bool{"pods": true},
+		AllowedKubectlVerbMap:     map[string]bool{"get": true, "logs": true},
+	}
+
+	testCases := []struct {
+		Name           string
+		Message        string
+		User           User
+		IsOtherChannel bool
+		RunCmdErr      error
+		ExpectedEvent  *audit.Event
+	}{
+		{
+			Name:    "Succeeded kubectl command",
+			Message: "get pods",
+			User:    User{ID: "U-DEV"},
+			ExpectedEvent: &audit.Event{
+				Command: "get pods",
+				User:    "U-DEV",
+				Allowed: true,
+				Status:  audit.StatusSucceeded,
+			},
+		},
+		{
+			Name:      "Failed kubectl command",
+			Message:   "get pods",
+			User:      User{ID: "U-DEV"},
+			RunCmdErr: errors.New("connection refused"),
+			ExpectedEvent: &audit.Event{
+				Command: "get pods",
+				User:    "U-DEV",
+				Allowed: true,
+				Status:  audit.StatusFailed,
+				Error:   "connection refused",
+			},
+		},
+		{
+			Name:    "Command not allowed for user",
+			Message: "logs my-pod",
+			User:    User{ID: "U-DEV"},
+			ExpectedEvent: &audit.Event{
+				Command: "logs my-pod",
+				User:    "U-DEV",
+				Allowed: false,
+				Status:  audit.StatusDenied,
+				Error:   "user not allowed",
+			},
+		},
+		{
+			Name:    "Unsupported resource",
+			Message: "get secrets",
+			User:    User{ID: "U-DEV"},
+			ExpectedEvent: &audit.Event{
+				Command: "get secrets",
+				User:    "U-DEV",
+				Allowed: false,
+				Status:  audit.StatusDenied,
+				Error:   "unsupported command",
+			},
+		},
+		{
+			Name:    "BotKube command",
+			Message: "notifier status",
+			User:    User{ID: "U-DEV"},
+			ExpectedEvent: &audit.Event{
+				Command: "notifier status",
+				User:    "U-DEV",
+				Allowed: true,
+				Status:  audit.StatusSucceeded,
+			},
+		},
+		{
+			Name:           "Command in not configured channel",
+			Message:        "get pods",
+			User:           User{ID: "U-DEV"},
+			IsOtherChannel: true,
+			ExpectedEvent: &audit.Event{
+				Command: "get pods",
+				User:    "U-DEV",
+				Allowed: false,
+				Status:  audit.StatusDenied,
+				Error:   "not allowed in this channel",
+			},
+		},
+		{
+			Name:           "BotKube command in not configured channel",
+			Message:        "notifier stop",
+			User:           User{ID: "U-DEV"},
+			IsOtherChannel: true,
+			ExpectedEvent: &audit.Event{
+				Command: "notifier stop",
+				User:    "U-DEV",
+				Allowed: false,
+				Status:  audit.StatusDenied,
+				Error:   "not allowed in this channel",
+			},
+		},
+		{
+			Name:    "Command for another cluster",
+			Message: "get pods --cluster-name dev",
+			User:    User{ID: "U-DEV"},
+		},
+		{
+			Name:    "Commands list for another cluster",
+			Message: "commands list --cluster-name dev",
+			User:    User{ID: "U-DEV"},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// given
+			cfg := config.Config{
+				Settings: config.Settings{ClusterName: "prod"},
+				Executors: config.IndexableMap[config.Executors]{
+					"kubectl": {Kubectl: config.Kubectl{
+						Enabled: true,
+						Commands: config.Commands{
+							Verbs:     []string{"get", "logs"},
+							Resources: []string{"pods"},
+						},
+					}},
+				},
+				Communications: config.IndexableMap[config.Communications]{
+					"default": {Slack: config.Slack{
+						Authorization: config.Authorization{
+							Enabled: true,
+							Rules: []config.AuthorizationRule{
+								{Verbs: []string{"get", "notifier"}, Users: []string{"U-DEV"}},
+							},
+						},
+					}},
+				},
+			}
+			runCmdFn := func(_ context.Context, _ []string) (string, error) {
+				return "out", testCase.RunCmdErr
+			}
+			auditor := &fakeAuditor{}
+			logger, _ := logtest.NewNullLogger()
+			factory := NewExecutorFactory(logger, runCmdFn, nil, cfg, nil, nil, resMapping, &fakeAnalyticsReporter{}, auditor)
+
+			// when
+			factory.NewDefault(NewDefaultInput{
+				Platform:         config.SlackCommPlatformIntegration,
+				IsAuthChannel:    !testCase.IsOtherChannel,
+				ExecutorBindings: []string{"kubectl"},
+				User:             testCase.User,
+				Message:          testCase.Message,
+				Channel:          "C-OPS",
+			}).Execute()
+
+			// then
+			events := auditor.Events()
+			if testCase.ExpectedEvent == nil {
+				assert.Empty(t, events)
+				return
+			}
+			require.Len(t, events, 1)
+			event := events[0]
+			assert.False(t, event.Timestamp.IsZero())
+			event.Timestamp, event.DurationMs = testCase.ExpectedEvent.Timestamp, 0
+
+			expected := *testCase.ExpectedEvent
+			expected.Cluster = "prod"
+			expected.Platform = config.SlackCommPlatformIntegration
+			expected.Channel = "C-OPS"
+			assert.Equal(t, expected, event)
+		})
+	}
+}
+
+type fakeAuditor struct {
+	mu     sync.Mutex
+	events []audit.Event
+}
+
+func (f *fakeAuditor) AuditCommand(event audit.Event) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.events = append(f.events, event)
+}
+
+func (f *fakeAuditor) Events() []audit.Event {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]audit.Event{}, f.events...)
+}
@@ -0,0 +1,131 @@
+package execute
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/kubeshop/botkube/pkg/config"
+	"github.com/kubeshop/botkube/pkg/utils"
+)
+
+const (
+	userNotAllowedMsg   = "Sorry, you don't have permission to run `%s` command on cluster '%s'."
+	impersonationErrMsg = "Sorry, I can't run this command on cluster '%s': %s."
+
+	allVerbs = "*"
+)
+
+var (
+	// alwaysAllowedVerbs contains commands which don't need the user-level authorization.
+	alwaysAllowedVerbs = map[string]bool{
+		"help": true,
+	}
+	// impersonationFlags are the kubectl flags which cannot be set by the users if the impersonation is enabled.
+	impersonationFlags = []string{"--as", "--as-group", "--as-uid"}
+
+	errUserNotMapped         = errors.New("your user is not mapped to any Kubernetes user")
+	errImpersonationOverride = errors.New("the impersonation flags are not allowed")
+)
+
+// User is the identity of the user who sent the message.
+type User struct {
+	// ID is the platform user ID, e.g. Slack user ID or Teams AAD object ID.
+	ID string
+	// Groups contains IDs of the user groups, e.g. Slack user groups or Discord roles.
+	Groups []string
+}
+
+// authorization returns the user-level authorization configuration of the current platform.
+func (e *DefaultExecutor) authorization() config.Authorization {
+	comm := e.cfg.Communications.GetFirst()
+	switch e.Platform {
+	case config.SlackCommPlatformIntegration:
+		return comm.Slack.Authorization
+	case config.MattermostCommPlatformIntegration:
+		return comm.Mattermost.Authorization
+	case config.DiscordCommPlatformIntegration:
+		return comm.Discord.Authorization
+	case config.TeamsCommPlatformIntegration:
+		return comm.Teams.Authorization
+	}
+	return config.Authorization{}
+}
+
+// isUserAllowed returns true if the user is allowed to run the commands with a given verb.
+func (e *DefaultExecutor) isUserAllowed(verb string) bool {
+	authz := e.authorization()
+	if !authz.Enabled || alwaysAllowedVerbs[verb] {
+		return true
+	}
+
+	for _, rule := range authz.Rules {
+		if !utils.Contains(rule.Verbs, verb) && !utils.Contains(rule.Verbs, allVerbs) {
+			continue
+		}
+		if matchesUser(rule, e.User) {
+			return true
+		}
+	}
+	return false
+}
+
+// isDirectMessageAllowed returns true if the user is allowed to run any command, and, in a result, to send direct messages.
+func (e *DefaultExecutor) isDirectMessageAllowed() bool {
+	for _, rule := range e.authorization().Rules {
+		if matchesUser(rule, e.User) {
+			return true
+		}
+	}
+	return false
+}
+
+// impersonationArgs returns the kubectl flags which impersonate the Kubernetes user mapped to the chat user.
+// It returns an error if the impersonation is enabled, but the user is not mapped or the command sets the impersonation flags.
+func (e *DefaultExecutor) impersonationArgs(args []string) ([]string, error) {
+	impersonation := e.authorization().Impersonation
+	if !impersonation.Enabled {
+		return nil, nil
+	}
+	if hasImpersonationFlags(args) {
+		return nil, errImpersonationOverride
+	}
+
+	for _, user := range impersonation.Users {
+		if user.ID == "" || user.ID != e.User.ID || user.Username == "" {
+			continue
+		}
+		out := []string{"--as", user.Username}
+		for _, group := range user.Groups {
+			out = append(out, "--as-group", group)
+		}
+		return out, nil
+	}
+	return nil, errUserNotMapped
+}
+
+// hasImpersonationFlags returns true if the kubectl flags set the impersonation. The arguments passed to the container are skipped.
+func hasImpersonationFlags(args []string) bool {
+	for _, arg := range args {
+		if arg == "--" {
+			return false
+		}
+		for _, flag := range impersonationFlags {
+			if arg == flag || strings.HasPrefix(arg, flag+"=") {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func matchesUser(rule config.AuthorizationRule, user User) bool {
+	if user.ID != "" && utils.Contains(rule.Users, user.ID) {
+		return true
+	}
+	for _, group := range user.Groups {
+		if utils.Contains(rule.Groups, group) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,231 @@
+package execute
+
+import (
+	"context"
+	"testing"
+
+	logtest "github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kubeshop/botkube/pkg/config"
+)
+
+func TestDefaultExecutor_UserAuthorization(t *testing.T) {
+	cfg := config.Config{
+		Settings: config.Settings{ClusterName: "prod"},
+		Executors: config.IndexableMap[config.Executors]{
+			"kubectl": {Kubectl: config.Kubectl{
+				Enabled: true,
+				Commands: config.Commands{
+					Verbs:     []string{"get", "rollout"},
+					Resources: []string{"pods", "deployments"},
+				},
+			}},
+		},
+		Communications: config.IndexableMap[config.Communications]{
+			"default": {Slack: config.Slack{
+				Authorization: config.Authorization{
+					Enabled: true,
+					Rules: []config.AuthorizationRule{
+						{Verbs: []string{"get", "ping"}, Groups: []string{"S-DEVS"}},
+						{Verbs: []string{"*"}, Users: []string{"U-ADMIN"}},
+					},
+				},
+			}},
+		},
+	}
+	resMapping := ResourceMapping{
+		KindResourceMap:           map[string]string{"deployment": "deployments"},
+		AllowedKubectlResourceMap: map[string]bool{"pods": true, "deployments": true},
+		AllowedKubectlVerbMap:     map[string]bool{"get": true, "rollout": true},
+	}
+	developer := User{ID: "U-DEV", Groups: []string{"S-DEVS"}}
+
+	testCases := []struct {
+		Name            string
+		Message         string
+		Platform        config.CommPlatformIntegration
+		IsAuthChannel   bool
+		IsDirectMessage bool
+		User            User
+		ExpectedOutput  string
+	}{
+		{
+			Name:           "kubectl verb allowed for group",
+			Message:        "get pods",
+			IsAuthChannel:  true,
+			User:           developer,
+			ExpectedOutput: "Cluster: prod\nout",
+		},
+		{
+			Name:           "kubectl verb not allowed for group",
+			Message:        "rollout restart deployment/api",
+			IsAuthChannel:  true,
+			User:           developer,
+			ExpectedOutput: "Sorry, you don't have permission to run `rollout` command on cluster 'prod'.",
+		},
+		{
+			Name:           "BotKube command not allowed for group",
+			Message:        "notifier stop",
+			IsAuthChannel:  true,
+			User:           developer,
+			ExpectedOutput: "Sorry, you don't have permission to run `notifier` command on cluster 'prod'.",
+		},
+		{
+			Name:           "All verbs allowed for user",
+			Message:        "notifier status",
+			IsAuthChannel:  true,
+			User:           User{ID: "U-ADMIN"},
+			ExpectedOutput: "Notifications are on for cluster 'prod'",
+		},
+		{
+			Name:           "Help allowed for everyone",
+			Message:        "ping --help",
+			IsAuthChannel:  true,
+			User:           User{ID: "U-OTHER"},
+			ExpectedOutput: "Checks if BotKube is running on the cluster.\n\nUsage:\n  ping [flags]\n\nFlags:\n  --cluster-name string  Runs the command only on a given cluster.\n  --help                 Shows help for the command.\n",
+		},
+		{
+			Name:            "Direct message from allowed user",
+			Message:         "get pods",
+			IsDirectMessage: true,
+			User:            developer,
+			ExpectedOutput:  "Cluster: prod\nout",
+		},
+		{
+			Name:            "Direct message from other user",
+			Message:         "get pods",
+			IsDirectMessage: true,
+			User:            User{ID: "U-OTHER"},
+			ExpectedOutput:  "",
+		},
+		{
+			Name:           "Authorization disabled for platform",
+			Message:        "rollout restart deployment/api",
+			Platform:       config.DiscordCommPlatformIntegration,
+			IsAuthChannel:  true,
+			User:           User{ID: "U-OTHER"},
+			ExpectedOutput: "Cluster: prod\nout",
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// given
+			config.Notify = true
+			runCmdFn := func(_ context.Context, _ []string) (string, error) {
+				return "out", nil
+			}
+			logger, _ := logtest.NewNullLogger()
+			factory := NewExecutorFactory(logger, runCmdFn, nil, cfg, nil, nil, resMapping, &fakeAnalyticsReporter{}, nil)
+
+			platform := testCase.Platform
+			if platform == "" {
+				platform = config.SlackCommPlatformIntegration
+			}
+
+			// when
+			out := factory.NewDefault(NewDefaultInput{
+				Platform:         platform,
+				IsAuthChannel:    testCase.IsAuthChannel,
+				IsDirectMessage:  testCase.IsDirectMessage,
+				ExecutorBindings: []string{"kubectl"},
+				User:             testCase.User,
+				Message:          testCase.Message,
+			}).Execute()
+
+			// then
+			assert.Equal(t, testCase.ExpectedOutput, out)
+		})
+	}
+}
+
+func TestDefaultExecutor_Impersonation(t *testing.T) {
+	cfg := config.Config{
+		Settings: config.Settings{ClusterName: "prod"},
+		Executors: config.IndexableMap[config.Executors]{
+			"kubectl": {Kubectl: config.Kubectl{
+				Enabled: true,
+				Commands: config.Commands{
+					Verbs:     []string{"get", "logs"},
+					Resources: []string{"pods"},
+				},
+			}},
+		},
+		Communications: config.IndexableMap[config.Communications]{
+			"default": {Slack: config.Slack{
+				Authorization: config.Authorization{
+					Impersonation: config.Impersonation{
+						Enabled: true,
+						Users: []config.ImpersonatedUser{
+							{ID: "U-DEV", Username: "jane@example.com", Groups: []string{"developers", "oncall"}},
+						},
+					},
+				},
+			}},
+		},
+	}
+	resMapping := ResourceMapping{
+		AllowedKubectlResourceMap: map[string]bool{"pods": true},
+		AllowedKubectlVerbMap:     map[string]bool{"get": true, "logs": true},
+	}
+
+	testCases := []struct {
+		Name               string
+		Message            string
+		UserID             string
+		ExpectedOutput     string
+		ExpectedKubectlCmd []string
+	}{
+		{
+			Name:               "Mapped user",
+			Message:            "get pods",
+			UserID:             "U-DEV",
+			ExpectedOutput:     "Cluster: prod\nout",
+			ExpectedKubectlCmd: []string{"--as", "jane@example.com", "--as-group", "developers", "--as-group", "oncall", "get", "pods"},
+		},
+		{
+			Name:               "Impersonation flag after arguments separator",
+			Message:            "logs my-pod -- --as admin",
+			UserID:             "U-DEV",
+			ExpectedOutput:     "Cluster: prod\nout",
+			ExpectedKubectlCmd: []string{"--as", "jane@example.com", "--as-group", "developers", "--as-group", "oncall", "logs", "my-pod", "--", "--as", "admin"},
+		},
+		{
+			Name:           "Impersonation flag set by user",
+			Message:        "get pods --as=system:admin",
+			UserID:         "U-DEV",
+			ExpectedOutput: "Sorry, I can't run this command on cluster 'prod': the impersonation flags are not allowed.",
+		},
+		{
+			Name:           "Not mapped user",
+			Message:        "get pods",
+			UserID:         "U-OTHER",
+			ExpectedOutput: "Sorry, I can't run this command on cluster 'prod': your user is not mapped to any Kubernetes user.",
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// given
+			var kubectlCmd []string
+			runCmdFn := func(_ context.Context, args []string) (string, error) {
+				kubectlCmd = args
+				return "out", nil
+			}
+			logger, _ := logtest.NewNullLogger()
+			factory := NewExecutorFactory(logger, runCmdFn, nil, cfg, nil, nil, resMapping, &fakeAnalyticsReporter{}, nil)
+
+			// when
+			out := factory.NewDefault(NewDefaultInput{
+				Platform:         config.SlackCommPlatformIntegration,
+				IsAuthChannel:    true,
+				ExecutorBindings: []string{"kubectl"},
+				User:             User{ID: testCase.UserID},
+				Message:          testCase.Message,
+			}).Execute()
+
+			// then
+			assert.Equal(t, testCase.ExpectedOutput, out)
+			assert.Equal(t, testCase.ExpectedKubectlCmd, kubectlCmd)
+		})
+	}
+}
@@ -0,0 +1,420 @@
+package execute
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+)
+
+const (
+	clusterNameFlag = "cluster-name"
+	helpFlag        = "help"
+
+	maxSuggestions        = 3
+	maxSuggestionDistance = 2
+)
+
+// flagType defines the type of the flag value.
+type flagType string
+
+const (
+	stringFlag flagType = "string"
+	boolFlag   flagType = "bool"
+)
+
+// flagSpec describes a command flag.
+type flagSpec struct {
+	Name        string
+	Type        flagType
+	Description string
+}
+
+// globalFlags are accepted by all BotKube commands.
+var globalFlags = []flagSpec{
+	{Name: clusterNameFlag, Type: stringFlag, Description: "Runs the command only on a given cluster."},
+	{Name: helpFlag, Type: boolFlag, Description: "Shows help for the command."},
+}
+
+// command is a node of the BotKube command tree.
+type command struct {
+	Name        string
+	Description string
+	// ArgsUsage describes the positional arguments, e.g. `<filter-name>`.
+	ArgsUsage string
+	// MaxArgs is the maximum number of the positional arguments.
+	MaxArgs int
+	// AuthChannelOnly commands are ignored in the channels other than the configured one,
+	// unless the cluster is selected with the `--cluster-name` flag.
+	AuthChannelOnly bool
+	Flags           []flagSpec
+	Subcommands     []*command
+	// Run executes the command. Commands without Run require one of the subcommands.
+	Run func(cmd parsedCommand) string
+}
+
+// parsedCommand is a command resolved from the user input.
+type parsedCommand struct {
+	Command *command
+	Path    []string
+	Args    []string
+	Flags   map[string]string
+	// AuthChannelOnly is true if any command on the path is restricted to the configured channel.
+	AuthChannelOnly bool
+}
+
+// Bool returns the value of a given bool flag.
+func (p parsedCommand) Bool(name string) bool {
+	val, _ := strconv.ParseBool(p.Flags[name])
+	return val
+}
+
+// FullName returns the command name, e.g. `notifier start`.
+func (p parsedCommand) FullName() string {
+	return strings.Join(p.Path, " ")
+}
+
+// unknownCommandError is returned when the command or subcommand is not found in the command tree.
+type unknownCommandError struct {
+	// Parent is the path of the last matched command. It is empty for the top level commands.
+	Parent      []string
+	Name        string
+	Suggestions []string
+}
+
+func (e *unknownCommandError) Error() string {
+	path := append(append([]string{}, e.Parent...), e.Name)
+	return fmt.Sprintf("unknown command %q", strings.Join(path, " "))
+}
+
+// incompleteCommandError is returned when the command requires one of the subcommands.
+type incompleteCommandError struct {
+	Command *command
+	Path    []string
+}
+
+func (e *incompleteCommandError) Error() string {
+	return fmt.Sprintf("command %q requires a subcommand", strings.Join(e.Path, " "))
+}
+
+// find returns the subcommand with a given name.
+func (c *command) find(name string) *command {
+	for _, sub := range c.Subcommands {
+		if sub.Name == name {
+			return sub
+		}
+	}
+	return nil
+}
+
+// subcommandNames returns names of the subcommands.
+func (c *command) subcommandNames() []string {
+	var out []string
+	for _, sub := range c.Subcommands {
+		out = append(out, sub.Name)
+	}
+	return out
+}
+
+// allFlags returns the command flags followed by the global ones.
+func (c *command) allFlags() []flagSpec {
+	return append(append([]flagSpec{}, c.Flags...), globalFlags...)
+}
+
+// lookupFlag returns the specification of the command or global flag with a given name.
+func (c *command) lookupFlag(name string) (flagSpec, bool) {
+	for _, spec := range c.allFlags() {
+		if spec.Name == name {
+			return spec, true
+		}
+	}
+	return flagSpec{}, false
+}
+
+// parse resolves the command from a given tokens. The flags can be specified anywhere after the command they belong to,
+// in the `--flag=value` or `--flag value` form.
+func (c *command) parse(tokens []string) (parsedCommand, error) {
+	out := parsedCommand{
+		Command: c,
+		Flags:   map[string]string{},
+	}
+
+	for i := 0; i < len(tokens); i++ {
+		token := tokens[i]
+		if strings.HasPrefix(token, "--") && len(token) > 2 {
+			name, value, hasValue := strings.Cut(strings.TrimPrefix(token, "--"), "=")
+			spec, found := out.Command.lookupFlag(name)
+			if !found {
+				return parsedCommand{}, fmt.Errorf("unknown flag --%s", name)
+			}
+
+			switch spec.Type {
+			case boolFlag:
+				if !hasValue {
+					value = "true"
+				}
+				if _, err := strconv.ParseBool(value); err != nil {
+					return parsedCommand{}, fmt.Errorf("invalid value %q for flag --%s: must be true or false", value, name)
+				}
+			default:
+				if !hasValue {
+					if i+1 >= len(tokens) {
+						return parsedCommand{}, fmt.Errorf("flag --%s needs a value", name)
+					}
+					i++
+					value = tokens[i]
+				}
+				if value == "" {
+					return parsedCommand{}, fmt.Errorf("flag --%s needs a value", name)
+				}
+			}
+			out.Flags[spec.Name] = value
+			continue
+		}
+
+		if len(out.Args) == 0 && len(out.Command.Subcommands) > 0 {
+			sub := out.Command.find(token)
+			if sub == nil && out.Command.Run == nil {
+				return parsedCommand{}, &unknownCommandError{
+					Parent:      out.Path,
+					Name:        token,
+					Suggestions: suggest(token, out.Command.subcommandNames()),
+				}
+			}
+			if sub != nil {
+				out.Command = sub
+				out.Path = append(out.Path, sub.Name)
+				out.AuthChannelOnly = out.AuthChannelOnly || sub.AuthChannelOnly
+				continue
+			}
+		}
+		out.Args = append(out.Args, token)
+	}
+
+	if out.Bool(helpFlag) {
+		return out, nil
+	}
+	if out.Command.Run == nil {
+		return parsedCommand{}, &incompleteCommandError{Command: out.Command, Path: out.Path}
+	}
+	if len(out.Args) > out.Command.MaxArgs {
+		return parsedCommand{}, fmt.Errorf("too many arguments for %q command. Usage: %s", out.FullName(), usage(out.Path, out.Command))
+	}
+
+	return out, nil
+}
+
+// usage returns the command usage, e.g. `filters enable <filter-name> [flags]`.
+func usage(path []string, cmd *command) string {
+	parts := append([]string{}, path...)
+	if len(cmd.Subcommands) > 0 {
+		format := "<%s>"
+		if cmd.Run != nil {
+			format = "[%s]"
+		}
+		parts = append(parts, fmt.Sprintf(format, strings.Join(cmd.subcommandNames(), "|")))
+	}
+	if cmd.ArgsUsage != "" {
+		parts = append(parts, cmd.ArgsUsage)
+	}
+	return strings.Join(append(parts, "[flags]"), " ")
+}
+
+// commandHelp returns help for a given command.
+func commandHelp(path []string, cmd *command) string {
+	buf := new(bytes.Buffer)
+	fmt.Fprintf(buf, "%s\n\nUsage:\n  %s\n", cmd.Description, usage(path, cmd))
+
+	if len(cmd.Subcommands) > 0 {
+		buf.WriteString("\nAvailable commands:\n")
+		w := tabwriter.NewWriter(buf, 5, 0, 2, ' ', 0)
+		for _, sub := range cmd.Subcommands {
+			name := sub.Name
+			if sub.ArgsUsage != "" {
+				name = fmt.Sprintf("%s %s", name, sub.ArgsUsage)
+			}
+			fmt.Fprintf(w, "  %s\t%s\n", name, sub.Description)
+		}
+		w.Flush()
+	}
+
+	buf.WriteString("\nFlags:\n")
+	writeFlags(buf, cmd.allFlags())
+	return buf.String()
+}
+
+func writeFlags(buf *bytes.Buffer, flags []flagSpec) {
+	w := tabwriter.NewWriter(buf, 5, 0, 2, ' ', 0)
+	for _, spec := range flags {
+		name := "--" + spec.Name
+		if spec.Type != boolFlag {
+			name = fmt.Sprintf("%s %s", name, spec.Type)
+		}
+		fmt.Fprintf(w, "  %s\t%s\n", name, spec.Description)
+	}
+	w.Flush()
+}
+
+// tokenize splits the input into tokens separated by whitespaces.
+// Single and double quotes group the words into a single token, and backslash escapes the next character.
+// Typographic quotes are treated as the regular ones, as some chat clients replace them automatically.
+func tokenize(input string) ([]string, error) {
+	var (
+		tokens   []string
+		current  strings.Builder
+		inToken  bool
+		quote    rune
+		escaping bool
+	)
+
+	for _, r := range normalizeQuotes(input) {
+		switch {
+		case escaping:
+			current.WriteRune(r)
+			escaping = false
+		case r == '\\' && quote != '\'':
+			escaping = true
+			inToken = true
+		case quote != 0:
+			if r == quote {
+				quote = 0
+				continue
+			}
+			current.WriteRune(r)
+		case r == '"' || r == '\'':
+			quote = r
+			inToken = true
+		case isWhitespace(r):
+			if inToken {
+				tokens = append(tokens, current.String())
+				current.Reset()
+				inToken = false
+			}
+		default:
+			current.WriteRune(r)
+			inToken = true
+		}
+	}
+
+	if quote != 0 {
+		return nil, fmt.Errorf("missing closing quote (%c)", quote)
+	}
+	if escaping {
+		current.WriteRune('\\')
+	}
+	if inToken {
+		tokens = append(tokens, current.String())
+	}
+	return tokens, nil
+}
+
+func normalizeQuotes(in string) string {
+	return strings.NewReplacer("“", `"`, "”", `"`, "‘", "'", "’", "'").Replace(in)
+}
+
+func isWhitespace(r rune) bool {
+	return r == ' ' || r == '\t' || r == '\n' || r == '\r' || r == ' '
+}
+
+// extractClusterName removes the `--cluster-name` flag from the tokens and returns its value.
+func extractClusterName(tokens []string) ([]string, string, bool, error) {
+	var (
+		out         []string
+		clusterName string
+		found       bool
+	)
+	flag := ClusterFlag.String()
+	for i := 0; i < len(tokens); i++ {
+		token := tokens[i]
+		switch {
+		case token == flag:
+			if i+1 >= len(tokens) {
+				return nil, "", false, fmt.Errorf("flag %s needs a value", flag)
+			}
+			i++
+			clusterName = tokens[i]
+		case strings.HasPrefix(token, flag+"="):
+			clusterName = strings.TrimPrefix(token, flag+"=")
+		default:
+			out = append(out, token)
+			continue
+		}
+		if clusterName == "" {
+			return nil, "", false, fmt.Errorf("flag %s needs a value", flag)
+		}
+		found = true
+	}
+	return out, clusterName, found, nil
+}
+
+// suggest returns candidates similar to a given input, sorted from the most similar one.
+func suggest(input string, candidates []string) []string {
+	type match struct {
+		name     string
+		distance int
+	}
+
+	var matches []match
+	seen := map[string]struct{}{}
+	for _, candidate := range candidates {
+		if _, ok := seen[candidate]; ok || candidate == input {
+			continue
+		}
+		seen[candidate] = struct{}{}
+
+		distance := levenshtein(input, candidate)
+		isPrefix := len(input) >= 3 && strings.HasPrefix(candidate, input)
+		if !isPrefix && (distance > maxSuggestionDistance || distance >= len(input)) {
+			continue
+		}
+		matches = append(matches, match{name: candidate, distance: distance})
+	}
+
+	sort.Slice(matches, func(i, j int) bool {
+		if matches[i].distance == matches[j].distance {
+			return matches[i].name < matches[j].name
+		}
+		return matches[i].distance < matches[j].distance
+	})
+
+	var out []string
+	for i := 0; i < len(matches) && i < maxSuggestions; i++ {
+		out = append(out, matches[i].name)
+	}
+	return out
+}
+
+// levenshtein returns the edit distance between two strings.
+func levenshtein(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	prev := make([]int, len(rb)+1)
+	curr := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(ra); i++ {
+		curr[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			curr[j] = minInt(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(rb)]
+}
+
+func minInt(first int, rest ...int) int {
+	out := first
+	for _, v := range rest {
+		if v < out {
+			out = v
+		}
+	}
+	return out
+}
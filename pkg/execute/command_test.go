@@ -0,0 +1,233 @@
+package execute
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTokenize(t *testing.T) {
+	testCases := []struct {
+		Name           string
+		Input          string
+		ExpectedTokens []string
+		ExpectedErr    string
+	}{
+		{
+			Name:           "Whitespaces",
+			Input:          "  get pods\t-n  default ",
+			ExpectedTokens: []string{"get", "pods", "-n", "default"},
+		},
+		{
+			Name:           "Quotes",
+			Input:          `get pods -l 'app in (foo, bar)' --cluster-name="my cluster"`,
+			ExpectedTokens: []string{"get", "pods", "-l", "app in (foo, bar)", "--cluster-name=my cluster"},
+		},
+		{
+			Name:           "Typographic quotes",
+			Input:          "filters enable “Image Tag”",
+			ExpectedTokens: []string{"filters", "enable", "Image Tag"},
+		},
+		{
+			Name:           "Escaped characters",
+			Input:          `logs foo\ bar "say \"hi\"" 'no\escape'`,
+			ExpectedTokens: []string{"logs", "foo bar", `say "hi"`, `no\escape`},
+		},
+		{
+			Name:           "Empty quoted token",
+			Input:          `ack ""`,
+			ExpectedTokens: []string{"ack", ""},
+		},
+		{
+			Name:        "Missing closing quote",
+			Input:       `get pods -l "app=foo`,
+			ExpectedErr: `missing closing quote (")`,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			tokens, err := tokenize(testCase.Input)
+
+			if testCase.ExpectedErr != "" {
+				require.EqualError(t, err, testCase.ExpectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, testCase.ExpectedTokens, tokens)
+		})
+	}
+}
+
+func TestExtractClusterName(t *testing.T) {
+	testCases := []struct {
+		Name                string
+		Input               []string
+		ExpectedArgs        []string
+		ExpectedClusterName string
+		ExpectedFound       bool
+		ExpectedErr         string
+	}{
+		{
+			Name:         "No flag",
+			Input:        []string{"get", "pods"},
+			ExpectedArgs: []string{"get", "pods"},
+		},
+		{
+			Name:                "Separate value",
+			Input:               []string{"get", "pods", "--cluster-name", "dev", "-n", "default"},
+			ExpectedArgs:        []string{"get", "pods", "-n", "default"},
+			ExpectedClusterName: "dev",
+			ExpectedFound:       true,
+		},
+		{
+			Name:                "Value after equal sign",
+			Input:               []string{"--cluster-name=dev", "ping"},
+			ExpectedArgs:        []string{"ping"},
+			ExpectedClusterName: "dev",
+			ExpectedFound:       true,
+		},
+		{
+			Name:        "Missing value",
+			Input:       []string{"ping", "--cluster-name"},
+			ExpectedErr: "flag --cluster-name needs a value",
+		},
+		{
+			Name:        "Empty value",
+			Input:       []string{"ping", "--cluster-name="},
+			ExpectedErr: "flag --cluster-name needs a value",
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			args, clusterName, found, err := extractClusterName(testCase.Input)
+
+			if testCase.ExpectedErr != "" {
+				require.EqualError(t, err, testCase.ExpectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, testCase.ExpectedArgs, args)
+			assert.Equal(t, testCase.ExpectedClusterName, clusterName)
+			assert.Equal(t, testCase.ExpectedFound, found)
+		})
+	}
+}
+
+func TestCommand_Parse(t *testing.T) {
+	// given
+	noop := func(parsedCommand) string { return "" }
+	root := &command{
+		Subcommands: []*command{
+			{
+				Name: "filters",
+				Subcommands: []*command{
+					{Name: "list", Run: noop},
+					{Name: "enable", ArgsUsage: "<filter-name>", MaxArgs: 1, Run: noop},
+				},
+			},
+			{
+				Name:  "logs",
+				Flags: []flagSpec{{Name: "previous", Type: boolFlag}, {Name: "container", Type: stringFlag}},
+				Run:   noop,
+			},
+		},
+	}
+
+	t.Run("Subcommand with argument", func(t *testing.T) {
+		// when
+		cmd, err := root.parse([]string{"filters", "enable", "ImageTagChecker"})
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, "filters enable", cmd.FullName())
+		assert.Equal(t, []string{"ImageTagChecker"}, cmd.Args)
+	})
+
+	t.Run("Typed flags", func(t *testing.T) {
+		// when
+		cmd, err := root.parse([]string{"logs", "--container", "app", "--previous"})
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, "app", cmd.Flags["container"])
+		assert.True(t, cmd.Bool("previous"))
+	})
+
+	t.Run("Invalid bool flag", func(t *testing.T) {
+		// when
+		_, err := root.parse([]string{"logs", "--previous=maybe"})
+
+		// then
+		assert.EqualError(t, err, `invalid value "maybe" for flag --previous: must be true or false`)
+	})
+
+	t.Run("Unknown flag", func(t *testing.T) {
+		// when
+		_, err := root.parse([]string{"filters", "list", "--all"})
+
+		// then
+		assert.EqualError(t, err, "unknown flag --all")
+	})
+
+	t.Run("Unknown subcommand", func(t *testing.T) {
+		// when
+		_, err := root.parse([]string{"filters", "lsit"})
+
+		// then
+		var unknownErr *unknownCommandError
+		require.True(t, errors.As(err, &unknownErr))
+		assert.Equal(t, []string{"filters"}, unknownErr.Parent)
+		assert.Equal(t, []string{"list"}, unknownErr.Suggestions)
+		assert.EqualError(t, err, `unknown command "filters lsit"`)
+	})
+
+	t.Run("Missing subcommand", func(t *testing.T) {
+		// when
+		_, err := root.parse([]string{"filters"})
+
+		// then
+		var incompleteErr *incompleteCommandError
+		assert.True(t, errors.As(err, &incompleteErr))
+	})
+
+	t.Run("Help flag of incomplete command", func(t *testing.T) {
+		// when
+		cmd, err := root.parse([]string{"filters", "--help"})
+
+		// then
+		require.NoError(t, err)
+		assert.True(t, cmd.Bool(helpFlag))
+	})
+
+	t.Run("Too many arguments", func(t *testing.T) {
+		// when
+		_, err := root.parse([]string{"filters", "enable", "foo", "bar"})
+
+		// then
+		assert.EqualError(t, err, `too many arguments for "filters enable" command. Usage: filters enable <filter-name> [flags]`)
+	})
+}
+
+func TestSuggest(t *testing.T) {
+	candidates := []string{"filters", "notifier", "ping", "get", "logs", "top"}
+	testCases := []struct {
+		Input    string
+		Expected []string
+	}{
+		{Input: "filter", Expected: []string{"filters"}},
+		{Input: "notif", Expected: []string{"notifier"}},
+		{Input: "pnig", Expected: []string{"ping"}},
+		{Input: "gte", Expected: []string{"get"}},
+		{Input: "tog", Expected: []string{"top", "logs"}},
+		{Input: "ge", Expected: []string{"get"}},
+		{Input: "describe", Expected: nil},
+		{Input: "ping", Expected: nil},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.Input, func(t *testing.T) {
+			assert.Equal(t, testCase.Expected, suggest(testCase.Input, candidates))
+		})
+	}
+}
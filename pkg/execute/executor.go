@@ -2,16 +2,22 @@ package execute
 
 import (
 	"bytes"
+	"context"
+	"errors"
 	"fmt"
+	"io"
 	"sort"
 	"strings"
 	"text/tabwriter"
-	"unicode"
+	"time"
 
 	"github.com/sirupsen/logrus"
 	"gopkg.in/yaml.v3"
 
+	"github.com/kubeshop/botkube/pkg/ack"
+	"github.com/kubeshop/botkube/pkg/audit"
 	"github.com/kubeshop/botkube/pkg/config"
+	"github.com/kubeshop/botkube/pkg/events"
 	"github.com/kubeshop/botkube/pkg/filterengine"
 	"github.com/kubeshop/botkube/pkg/utils"
 	"github.com/kubeshop/botkube/pkg/version"
@@ -22,22 +28,8 @@ var (
 	ValidNotifierCommand = map[string]bool{
 		"notifier": true,
 	}
-	validPingCommand = map[string]bool{
-		"ping": true,
-	}
-	validVersionCommand = map[string]bool{
-		"version": true,
-	}
-	validFilterCommand = map[string]bool{
-		"filters": true,
-	}
-	validInfoCommand = map[string]bool{
-		"commands": true,
-	}
 	validDebugCommands = map[string]bool{
-		"exec":         true,
 		"logs":         true,
-		"attach":       true,
 		"auth":         true,
 		"api-versions": true,
 		"cluster-info": true,
@@ -45,28 +37,39 @@ var (
 		"drain":        true,
 		"uncordon":     true,
 	}
-
-	kubectlBinary = "/usr/local/bin/kubectl"
 )
 
 const (
-	notifierStopMsg    = "Sure! I won't send you notifications from cluster '%s' anymore."
-	unsupportedCmdMsg  = "Command not supported. Please run /botkubehelp to see supported commands."
-	kubectlDisabledMsg = "Sorry, the admin hasn't given me the permission to execute kubectl command on cluster '%s'."
-	filterNameMissing  = "You forgot to pass filter name. Please pass one of the following valid filters:\n\n%s"
-	filterEnabled      = "I have enabled '%s' filter on '%s' cluster."
-	filterDisabled     = "Done. I won't run '%s' filter on '%s' cluster."
+	notifierStopMsg       = "Sure! I won't send you notifications from cluster '%s' anymore."
+	unsupportedCmdMsg     = "Command not supported. Please run `@BotKube help` to see supported commands."
+	didYouMeanMsg         = "Command not supported. Did you mean %s? Please run `@BotKube help` to see supported commands."
+	invalidCmdMsg         = "Invalid command: %s. Please run `@BotKube help` to see command options."
+	kubectlDisabledMsg    = "Sorry, the admin hasn't given me the permission to execute kubectl command on cluster '%s'."
+	filterNameMissing     = "You forgot to pass filter name. Please pass one of the following valid filters:\n\n%s"
+	filterEnabled         = "I have enabled '%s' filter on '%s' cluster."
+	filterDisabled        = "Done. I won't run '%s' filter on '%s' cluster."
+	ackDisabledMsg        = "Sorry, the admin hasn't enabled the acknowledgements on cluster '%s'."
+	ackIDMissingMsg       = "You forgot to pass the event ID, e.g. `ack a1b2c3`."
+	ackNotFoundMsg        = "There is no pending event with ID '%s' on cluster '%s'."
+	ackSuccessMsg         = "Done. Event '%s' about %s on cluster '%s' has been acknowledged."
+	noPendingEventsMsg    = "There are no events waiting for the acknowledgement on cluster '%s'."
+	kubectlHelpMsg        = "kubectl commands are also allowed, e.g. `get pods`. Run `commands list` to see allowed verbs and resources."
+	noKubectlExecutorsMsg = "Sorry, no kubectl executor is bound to this channel on cluster '%s'."
+	kubectlPermissionMsg  = "Sorry, this channel doesn't have permission to run this command on cluster '%s'. Missing permission: %s."
+	cmdTimeoutMsg         = "Sorry, the command didn't finish in %s and has been stopped."
+	cmdCancelledMsg       = "The command has been cancelled."
+	outputTruncatedMsg    = "The output has been truncated, as it exceeded %d bytes."
+	noRunningCmdsMsg      = "There are no running commands on cluster '%s'."
+	cmdNotFoundMsg        = "There is no running command with ID '%s' on cluster '%s'."
+	cmdCancelSuccessMsg   = "Done. Command '%s' (`%s`) on cluster '%s' has been cancelled."
 
 	// NotifierStartMsg notifier enabled response message
 	NotifierStartMsg = "Brace yourselves, notifications are coming from cluster '%s'."
 	// IncompleteCmdMsg incomplete command response message
-	IncompleteCmdMsg = "You missed to pass options for the command. Please run /botkubehelp to see command options."
+	IncompleteCmdMsg = "You missed to pass options for the command."
 	// WrongClusterCmdMsg incomplete command response message
 	WrongClusterCmdMsg = "Sorry, the admin hasn't configured me to do that for the cluster '%s'."
 
-	// Custom messages for teams platform
-	teamsUnsupportedCmdMsg = "Command not supported. Please visit botkube.io/usage to see supported commands."
-
 	anonymizedInvalidVerb = "{invalid verb}"
 )
 
@@ -74,19 +77,43 @@ const (
 type DefaultExecutor struct {
 	cfg          config.Config
 	filterEngine filterengine.FilterEngine
+	ackTracker   *ack.Tracker
 	log          logrus.FieldLogger
 	runCmdFn     CommandRunnerFunc
+	streamCmdFn  CommandStreamerFunc
 	resMapping   ResourceMapping
-
-	Message       string
-	IsAuthChannel bool
-	Platform      config.CommPlatformIntegration
+	jobs         *jobTracker
+	approvals    *approvalTracker
+	auditor      Auditor
+
+	Message         string
+	IsAuthChannel   bool
+	IsDirectMessage bool
+	User            User
+	Platform        config.CommPlatformIntegration
+	Channel         string
+
+	// executorBindings are the executors bound to the channel in which the message was posted.
+	executorBindings []string
+	// outputStreamer is nil if the platform doesn't support streaming the command output.
+	outputStreamer OutputStreamer
+	// actions are the interactive elements for the response, e.g. the buttons approving the command.
+	actions []Action
+	// auditEvent contains the result of the command, which is completed and recorded when the command finishes.
+	auditEvent audit.Event
+	// isOtherCluster is true if the message is addressed to another cluster, so it's not recorded.
+	isOtherCluster bool
 
 	analyticsReporter AnalyticsReporter
 }
 
-// CommandRunnerFunc is a function which runs arbitrary commands
-type CommandRunnerFunc func(command string, args []string) (string, error)
+// CommandRunnerFunc is a function which runs kubectl commands with given arguments.
+// The command must be stopped when the context is done.
+type CommandRunnerFunc func(ctx context.Context, args []string) (string, error)
+
+// CommandStreamerFunc is a function which runs kubectl commands with given arguments and writes their output
+// to a given writer as it comes. It must return when the context is done.
+type CommandStreamerFunc func(ctx context.Context, args []string, out io.Writer) error
 
 // NotifierAction creates custom type for notifier actions
 type NotifierAction string
@@ -129,6 +156,18 @@ const (
 	FilterDisable FiltersAction = "disable"
 )
 
+// EventsAction for options in events commands
+type EventsAction string
+
+// Events command options
+const (
+	EventsPending EventsAction = "pending"
+)
+
+func (action EventsAction) String() string {
+	return string(action)
+}
+
 // infoAction for options in Info commands
 type infoAction string
 
@@ -143,81 +182,365 @@ func (action FiltersAction) String() string {
 
 // Execute executes commands and returns output
 func (e *DefaultExecutor) Execute() string {
-	clusterName := e.cfg.Settings.ClusterName
+	start := time.Now()
+	out := e.execute()
+	if e.isOtherCluster {
+		// the messages addressed to other clusters are recorded by them
+		return out
+	}
+	if out == "" && e.auditEvent.Status == "" {
+		// the commands are ignored without the response only if they are not allowed in the current channel
+		e.setAuditResult(audit.StatusDenied, "not allowed in this channel")
+	}
+	e.auditCommand(start)
+	return out
+}
+
+func (e *DefaultExecutor) execute() string {
 	// Remove hyperlink if it got added automatically
 	command := utils.RemoveHyperlink(e.Message)
-	args := strings.Fields(strings.TrimSpace(command))
+	tokens, err := tokenize(command)
+	if err != nil {
+		e.setAuditResult(audit.StatusFailed, err.Error())
+		return e.replyIfAuthChannel(fmt.Sprintf(invalidCmdMsg, err), e.IsAuthChannel)
+	}
+
+	args, selectedCluster, isClusterSelected, err := extractClusterName(tokens)
+	if err != nil {
+		e.setAuditResult(audit.StatusFailed, err.Error())
+		return e.replyIfAuthChannel(fmt.Sprintf(invalidCmdMsg, err), e.IsAuthChannel)
+	}
+	if isClusterSelected && selectedCluster != e.cfg.Settings.ClusterName {
+		e.isOtherCluster = true
+		// the `commands` command keeps replying in the configured channel, so the users know the cluster is unknown
+		if e.IsAuthChannel && len(args) > 0 && args[0] == "commands" {
+			return fmt.Sprintf(WrongClusterCmdMsg, selectedCluster)
+		}
+		return "" // the command is addressed to another cluster
+	}
+	// commands which select this cluster explicitly are allowed in all channels
+	isAuthChannel := e.IsAuthChannel || isClusterSelected
+	// if the user-level authorization is enabled, direct messages are served only for the authorized users
+	if e.IsDirectMessage && e.authorization().Enabled {
+		isAuthChannel = e.isDirectMessageAllowed()
+	}
+
 	if len(args) == 0 {
-		if e.IsAuthChannel {
-			return e.printDefaultMsg(e.Platform)
+		return e.replyIfAuthChannel(e.rootHelp(e.commandTree(isAuthChannel)), isAuthChannel)
+	}
+
+	// aliased commands are checked in the same way as the commands sent directly
+	if alias, found := e.findAlias(args[0]); found {
+		args, err = expandAlias(args[0], alias, args[1:])
+		if err != nil {
+			e.setAuditResult(audit.StatusFailed, err.Error())
+			return e.replyIfAuthChannel(err.Error(), isAuthChannel)
 		}
-		return "" // this prevents all bots on all clusters to answer something
 	}
 
-	if len(args) >= 1 && e.resMapping.AllowedKubectlVerbMap[args[0]] {
-		if validDebugCommands[args[0]] || // Don't check for resource if is a valid debug command
-			(len(args) >= 2 && (e.resMapping.AllowedKubectlResourceMap[args[1]] || // Check if allowed resource
-				e.resMapping.AllowedKubectlResourceMap[e.resMapping.KindResourceMap[strings.ToLower(args[1])]] || // Check if matches with kind name
-				e.resMapping.AllowedKubectlResourceMap[e.resMapping.ShortnameResourceMap[strings.ToLower(args[1])]])) { // Check if matches with short name
-			isClusterNamePresent := strings.Contains(e.Message, "--cluster-name")
-			allowKubectl := e.cfg.Executors.GetFirst().Kubectl.Enabled
-			if !allowKubectl {
-				if isClusterNamePresent && clusterName == utils.GetClusterNameFromKubectlCmd(e.Message) {
-					return fmt.Sprintf(kubectlDisabledMsg, clusterName)
-				}
-				return ""
-			}
+	if e.resMapping.AllowedKubectlVerbMap[args[0]] {
+		if !e.isKubectlCommandAllowed(args) {
+			e.setAuditResult(audit.StatusDenied, "unsupported command")
+			return e.replyIfAuthChannel(e.unsupportedKubectlCommandMsg(args), isAuthChannel)
+		}
 
-			if e.cfg.Executors.GetFirst().Kubectl.RestrictAccess && !e.IsAuthChannel && isClusterNamePresent {
-				return ""
+		if !isKubectlEnabled(e.cfg.Executors) {
+			if isClusterSelected {
+				e.setAuditResult(audit.StatusDenied, "kubectl disabled")
+				return fmt.Sprintf(kubectlDisabledMsg, e.cfg.Settings.ClusterName)
 			}
-			return e.runKubectlCommand(args)
+			return ""
 		}
-	}
-	if ValidNotifierCommand[args[0]] {
-		return e.runNotifierCommand(args, clusterName, e.IsAuthChannel)
-	}
-	if validPingCommand[args[0]] {
-		res := e.runVersionCommand(args, clusterName)
-		if len(res) == 0 {
+		if !isAuthChannel {
 			return ""
 		}
-		return fmt.Sprintf("pong from cluster '%s'\n\n%s", clusterName, res)
+		if !e.isUserAllowed(args[0]) {
+			e.setAuditResult(audit.StatusDenied, "user not allowed")
+			return fmt.Sprintf(userNotAllowedMsg, args[0], e.cfg.Settings.ClusterName)
+		}
+		return e.runKubectlCommand(args)
 	}
-	if validVersionCommand[args[0]] {
-		return e.runVersionCommand(args, clusterName)
+
+	return e.runBotKubeCommand(args, isAuthChannel)
+}
+
+// runBotKubeCommand parses and runs the BotKube command.
+func (e *DefaultExecutor) runBotKubeCommand(args []string, isAuthChannel bool) string {
+	root := e.commandTree(isAuthChannel)
+	cmd, err := root.parse(args)
+	if err != nil {
+		e.setAuditResult(audit.StatusFailed, err.Error())
+		if !isAuthChannel {
+			return "" // this prevents all bots on all clusters to answer something
+		}
+		return e.commandErrorMsg(root, err)
 	}
-	// Check if filter command
-	if validFilterCommand[args[0]] {
-		return e.runFilterCommand(args, clusterName, e.IsAuthChannel)
+	if cmd.AuthChannelOnly && !isAuthChannel {
+		return ""
+	}
+	if verb := cmd.Path[0]; !cmd.Bool(helpFlag) && !e.isUserAllowed(verb) {
+		e.setAuditResult(audit.StatusDenied, "user not allowed")
+		return e.replyIfAuthChannel(fmt.Sprintf(userNotAllowedMsg, verb, e.cfg.Settings.ClusterName), isAuthChannel)
 	}
 
-	//Check if info command
-	if validInfoCommand[args[0]] {
-		return e.runInfoCommand(args, e.IsAuthChannel)
+	e.reportCommand(cmd.FullName())
+	if cmd.Bool(helpFlag) {
+		return commandHelp(cmd.Path, cmd.Command)
 	}
+	return cmd.Command.Run(cmd)
+}
+
+// commandTree returns the BotKube commands.
+func (e *DefaultExecutor) commandTree(isAuthChannel bool) *command {
+	help := &command{
+		Name:            "help",
+		Description:     "Shows help for BotKube commands.",
+		ArgsUsage:       "[command]",
+		MaxArgs:         2,
+		AuthChannelOnly: true,
+	}
+	root := &command{
+		Subcommands: []*command{
+			{
+				Name:        "ack",
+				Description: "Acknowledges the critical event, so it is not escalated.",
+				ArgsUsage:   "<event-id>",
+				MaxArgs:     1,
+				Run: func(cmd parsedCommand) string {
+					return e.runAckCommand(cmd.Args, isAuthChannel)
+				},
+			},
+			{
+				Name:            "approve",
+				Description:     "Runs a kubectl command waiting for the confirmation or approval.",
+				ArgsUsage:       "<request-id>",
+				MaxArgs:         1,
+				AuthChannelOnly: true,
+				Run:             e.runApproveCommand,
+			},
+			{
+				Name:            "cancel",
+				Description:     "Cancels a kubectl command started in this channel, or lists the running ones.",
+				ArgsUsage:       "[command-id]",
+				MaxArgs:         1,
+				AuthChannelOnly: true,
+				Run:             e.runCancelCommand,
+			},
+			{
+				Name:            "commands",
+				Description:     "Lists allowed kubectl verbs, resources and aliases.",
+				AuthChannelOnly: true,
+				Run:             e.runInfoCommand,
+				Subcommands: []*command{
+					{Name: string(infoList), Description: "Lists allowed kubectl verbs, resources and aliases.", Run: e.runInfoCommand},
+				},
+			},
+			{
+				Name:            "events",
+				Description:     "Manages events waiting for the acknowledgement.",
+				AuthChannelOnly: true,
+				Subcommands: []*command{
+					{Name: EventsPending.String(), Description: "Lists events waiting for the acknowledgement.", Run: e.runPendingEventsCommand},
+				},
+			},
+			{
+				Name:            "filters",
+				Description:     "Manages filters of the events.",
+				AuthChannelOnly: true,
+				Subcommands: []*command{
+					{Name: FilterList.String(), Description: "Lists filters.", Run: e.runFiltersListCommand},
+					{Name: FilterEnable.String(), Description: "Enables a given filter.", ArgsUsage: "<filter-name>", MaxArgs: 1, Run: e.runFilterToggleCommand(true)},
+					{Name: FilterDisable.String(), Description: "Disables a given filter.", ArgsUsage: "<filter-name>", MaxArgs: 1, Run: e.runFilterToggleCommand(false)},
+				},
+			},
+			help,
+			{
+				Name:            "notifier",
+				Description:     "Manages notifications.",
+				AuthChannelOnly: true,
+				Subcommands: []*command{
+					{Name: Start.String(), Description: "Starts sending notifications.", Run: e.runNotifierCommand},
+					{Name: Stop.String(), Description: "Stops sending notifications.", Run: e.runNotifierCommand},
+					{Name: Status.String(), Description: "Shows if notifications are enabled.", Run: e.runNotifierCommand},
+					{Name: ShowConfig.String(), Description: "Shows BotKube configuration.", Run: e.runNotifierCommand},
+				},
+			},
+			{
+				Name:        "ping",
+				Description: "Checks if BotKube is running on the cluster.",
+				Run: func(cmd parsedCommand) string {
+					return fmt.Sprintf("pong from cluster '%s'\n\n%s", e.cfg.Settings.ClusterName, e.findBotKubeVersion())
+				},
+			},
+			{
+				Name:            "reject",
+				Description:     "Rejects a kubectl command waiting for the confirmation or approval.",
+				ArgsUsage:       "<request-id>",
+				MaxArgs:         1,
+				AuthChannelOnly: true,
+				Run:             e.runRejectCommand,
+			},
+			{
+				Name:            "stop",
+				Description:     "Stops a streaming session started in this channel, or lists the running ones.",
+				ArgsUsage:       "[session-id]",
+				MaxArgs:         1,
+				AuthChannelOnly: true,
+				Run:             e.runStopCommand,
+			},
+			{
+				Name:        "version",
+				Description: "Shows BotKube and Kubernetes versions.",
+				Run: func(cmd parsedCommand) string {
+					return e.findBotKubeVersion()
+				},
+			},
+		},
+	}
+	help.Run = func(cmd parsedCommand) string {
+		return e.runHelpCommand(root, cmd.Args)
+	}
+	return root
+}
 
-	if e.IsAuthChannel {
-		return e.printDefaultMsg(e.Platform)
+// runHelpCommand returns help for a given command, or overview of all commands.
+func (e *DefaultExecutor) runHelpCommand(root *command, args []string) string {
+	if len(args) == 0 {
+		return e.rootHelp(root)
+	}
+
+	cmd := root
+	for i, name := range args {
+		sub := cmd.find(name)
+		if sub == nil {
+			return e.commandErrorMsg(root, &unknownCommandError{
+				Parent:      args[:i],
+				Name:        name,
+				Suggestions: suggest(name, cmd.subcommandNames()),
+			})
+		}
+		cmd = sub
 	}
-	return ""
+	return commandHelp(args, cmd)
 }
 
-func (e *DefaultExecutor) printDefaultMsg(p config.CommPlatformIntegration) string {
-	if p == config.TeamsCommPlatformIntegration {
-		return teamsUnsupportedCmdMsg
+// rootHelp returns overview of all BotKube commands.
+func (e *DefaultExecutor) rootHelp(root *command) string {
+	buf := new(bytes.Buffer)
+	buf.WriteString("BotKube commands:\n")
+	w := tabwriter.NewWriter(buf, 5, 0, 2, ' ', 0)
+	for _, cmd := range root.Subcommands {
+		fmt.Fprintf(w, "  %s\t%s\n", strings.TrimSuffix(usage([]string{cmd.Name}, cmd), " [flags]"), cmd.Description)
+	}
+	w.Flush()
+
+	if isKubectlEnabled(e.cfg.Executors) {
+		fmt.Fprintf(buf, "\n%s\n", kubectlHelpMsg)
+	}
+	buf.WriteString("\nRun `help <command>` to see details of a given command.\n\nGlobal flags:\n")
+	writeFlags(buf, globalFlags)
+	return buf.String()
+}
+
+// commandErrorMsg returns a message describing why a given command cannot be run.
+func (e *DefaultExecutor) commandErrorMsg(root *command, err error) string {
+	var unknownErr *unknownCommandError
+	var incompleteErr *incompleteCommandError
+	switch {
+	case errors.As(err, &unknownErr):
+		if len(unknownErr.Parent) > 0 {
+			e.reportCommand(fmt.Sprintf("%s %s", strings.Join(unknownErr.Parent, " "), anonymizedInvalidVerb))
+			return unsupportedCommandMsg(unknownErr.Parent, unknownErr.Suggestions)
+		}
+		// suggest also kubectl verbs and aliases for the top level commands
+		suggestions := suggest(unknownErr.Name, append(append(root.subcommandNames(), e.allowedKubectlVerbs()...), e.aliasNames()...))
+		return unsupportedCommandMsg(nil, suggestions)
+	case errors.As(err, &incompleteErr):
+		return fmt.Sprintf("%s\n\n%s", IncompleteCmdMsg, commandHelp(incompleteErr.Path, incompleteErr.Command))
+	default:
+		return fmt.Sprintf(invalidCmdMsg, err)
 	}
-	return unsupportedCmdMsg
 }
 
-// Trim single and double quotes from ends of string
-func (e *DefaultExecutor) trimQuotes(clusterValue string) string {
-	return strings.TrimFunc(clusterValue, func(r rune) bool {
-		if r == unicode.SimpleFold('\u0027') || r == unicode.SimpleFold('\u0022') {
-			return true
+// unsupportedKubectlCommandMsg suggests allowed resources for a given kubectl command.
+func (e *DefaultExecutor) unsupportedKubectlCommandMsg(args []string) string {
+	idx, found := kubectlResourceIndex(args)
+	if !found {
+		return unsupportedCmdMsg
+	}
+
+	var resources []string
+	for resource, allowed := range e.resMapping.AllowedKubectlResourceMap {
+		if allowed {
+			resources = append(resources, resource)
 		}
+	}
+	return unsupportedCommandMsg(args[:idx], suggest(strings.ToLower(args[idx]), resources))
+}
+
+// unsupportedCommandMsg returns the unsupported command message with suggestions of similar commands.
+func unsupportedCommandMsg(parent []string, suggestions []string) string {
+	if len(suggestions) == 0 {
+		return unsupportedCmdMsg
+	}
+
+	var quoted []string
+	for _, suggestion := range suggestions {
+		quoted = append(quoted, fmt.Sprintf("`%s`", strings.Join(append(append([]string{}, parent...), suggestion), " ")))
+	}
+	if len(quoted) == 1 {
+		return fmt.Sprintf(didYouMeanMsg, quoted[0])
+	}
+	return fmt.Sprintf(didYouMeanMsg, strings.Join(quoted[:len(quoted)-1], ", ")+" or "+quoted[len(quoted)-1])
+}
+
+func (e *DefaultExecutor) isKubectlCommandAllowed(args []string) bool {
+	if validDebugCommands[args[0]] { // Don't check for resource if is a valid debug command
+		return true
+	}
+	cmd, err := e.parseKubectlCommand(args)
+	if err != nil {
+		return true // the invalid command is reported when it's run
+	}
+	resources := cmd.Resources
+	if len(resources) == 0 {
 		return false
-	})
+	}
+	for _, resource := range resources {
+		if !e.resMapping.AllowedKubectlResourceMap[resource] {
+			return false
+		}
+	}
+	return true
+}
+
+func (e *DefaultExecutor) allowedKubectlVerbs() []string {
+	if !isKubectlEnabled(e.cfg.Executors) {
+		return nil
+	}
+
+	var verbs []string
+	for verb, allowed := range e.resMapping.AllowedKubectlVerbMap {
+		if allowed {
+			verbs = append(verbs, verb)
+		}
+	}
+	return verbs
+}
+
+func (e *DefaultExecutor) replyIfAuthChannel(msg string, isAuthChannel bool) string {
+	if !isAuthChannel {
+		return "" // this prevents all bots on all clusters to answer something
+	}
+	return msg
+}
+
+func (e *DefaultExecutor) reportCommand(cmd string) {
+	err := e.analyticsReporter.ReportCommand(e.Platform, cmd)
+	if err != nil {
+		// TODO: Return error when the DefaultExecutor is refactored as a part of https://github.com/kubeshop/botkube/issues/589
+		e.log.Errorf("while reporting executed command: %s", err.Error())
+	}
 }
 
 func (e *DefaultExecutor) runKubectlCommand(args []string) string {
@@ -225,84 +548,169 @@ func (e *DefaultExecutor) runKubectlCommand(args []string) string {
 	// The length of the slice was already checked before
 	// See the DefaultExecutor.Execute() logic.
 	verb := args[0]
-	err := e.analyticsReporter.ReportCommand(e.Platform, verb)
+	e.reportCommand(verb)
+
+	clusterName := e.cfg.Settings.ClusterName
+	command := strings.Join(args, " ")
+	cmd, err := e.parseKubectlCommand(args)
 	if err != nil {
-		// TODO: Return error when the DefaultExecutor is refactored as a part of https://github.com/kubeshop/botkube/issues/589
-		e.log.Errorf("while reporting executed command: %s", err.Error())
+		e.setAuditResult(audit.StatusFailed, err.Error())
+		return fmt.Sprintf(invalidCmdMsg, err)
+	}
+	profile, err := e.authorizeKubectlCommand(cmd)
+	if err != nil {
+		e.setAuditResult(audit.StatusDenied, fmt.Sprintf("missing %s", err.Error()))
+		e.log.Infof("Refusing to run kubectl %s command: missing %s", verb, err.Error())
+		return e.kubectlPermissionDeniedMsg(err)
+	}
+
+	impersonationArgs, err := e.impersonationArgs(args)
+	if err != nil {
+		e.log.Infof("Refusing to run kubectl %s command: %s", verb, err.Error())
+		e.setAuditResult(audit.StatusDenied, err.Error())
+		return fmt.Sprintf(impersonationErrMsg, clusterName, err.Error())
 	}
 
+	// run commands in the default namespace of the executor which allowed the command
+	if cmd.Namespaced && cmd.Namespace == "" && !cmd.AllNamespaces && len(profile.DefaultNamespace) != 0 {
+		args = append([]string{"-n", profile.DefaultNamespace}, utils.DeleteDoubleWhiteSpace(args)...)
+		cmd.Namespace = profile.DefaultNamespace
+	}
+
+	isStreaming := hasStreamingFlags(args)
+	if isStreaming && !e.canStreamOutput() {
+		// the output can't be streamed on this platform, so the command is run only once
+		args = withoutStreamingFlags(args)
+		isStreaming = false
+	}
+
+	// run commands as the Kubernetes user mapped to the chat user
+	finalArgs := append(impersonationArgs, args...)
+
+	if requirement, found := profile.requirementFor(cmd); found {
+		return e.requestApproval(pendingCommand{
+			Command:     command,
+			Args:        finalArgs,
+			Verb:        verb,
+			Kubectl:     cmd,
+			Streaming:   isStreaming,
+			Requester:   e.User,
+			Platform:    e.Platform,
+			Requirement: requirement,
+		})
+	}
+	return e.runKubectlJob(verb, command, finalArgs, isStreaming)
+}
+
+// kubectlPermissionDeniedMsg returns the message for the kubectl command refused by the executors bound to the current channel.
+func (e *DefaultExecutor) kubectlPermissionDeniedMsg(err error) string {
 	clusterName := e.cfg.Settings.ClusterName
-	defaultNamespace := e.cfg.Executors.GetFirst().Kubectl.DefaultNamespace
-	isAuthChannel := e.IsAuthChannel
-	// run commands in namespace specified under Config.Settings.DefaultNamespace field
-	if !utils.Contains(args, "-n") && !utils.Contains(args, "--namespace") && len(defaultNamespace) != 0 {
-		args = append([]string{"-n", defaultNamespace}, utils.DeleteDoubleWhiteSpace(args)...)
-	}
-
-	// Remove unnecessary flags
-	var finalArgs []string
-	isClusterNameArg := false
-	for index, arg := range args {
-		if isClusterNameArg {
-			isClusterNameArg = false
-			continue
-		}
-		if arg == AbbrFollowFlag.String() || strings.HasPrefix(arg, FollowFlag.String()) {
-			continue
-		}
-		if arg == AbbrWatchFlag.String() || strings.HasPrefix(arg, WatchFlag.String()) {
-			continue
-		}
-		// Check --cluster-name flag
-		if strings.HasPrefix(arg, ClusterFlag.String()) {
-			// Check if flag value in current or next argument and compare with config.settings.clusterName
-			if arg == ClusterFlag.String() {
-				if index == len(args)-1 || e.trimQuotes(args[index+1]) != clusterName {
-					return ""
-				}
-				isClusterNameArg = true
-			} else {
-				if e.trimQuotes(strings.SplitAfterN(arg, ClusterFlag.String()+"=", 2)[1]) != clusterName {
-					return ""
-				}
-			}
-			isAuthChannel = true
-			continue
-		}
-		finalArgs = append(finalArgs, arg)
+	if errors.Is(err, errNoKubectlExecutors) {
+		return fmt.Sprintf(noKubectlExecutorsMsg, clusterName)
 	}
-	if !isAuthChannel {
-		return ""
+	return fmt.Sprintf(kubectlPermissionMsg, clusterName, err.Error())
+}
+
+// runKubectlJob runs the authorized kubectl command and returns its output, or starts the streaming session.
+func (e *DefaultExecutor) runKubectlJob(verb, command string, finalArgs []string, isStreaming bool) string {
+	clusterName := e.cfg.Settings.ClusterName
+	if isStreaming {
+		e.setAuditResult(audit.StatusStarted, "")
+		return fmt.Sprintf("Cluster: %s\n%s", clusterName, e.startStreamingSession(command, finalArgs))
 	}
-	// Get command runner
-	out, err := e.runCmdFn(kubectlBinary, finalArgs)
+
+	out, err := e.runJob(command, finalArgs)
 	if err != nil {
+		e.setAuditResult(audit.StatusFailed, err.Error())
+	}
+	var truncatedErr *outputTruncatedError
+	switch {
+	case err == nil:
+		return fmt.Sprintf("Cluster: %s\n%s", clusterName, out)
+	case errors.As(err, &truncatedErr):
+		// the command succeeded, only its output is incomplete
+		e.setAuditResult(audit.StatusSucceeded, err.Error())
+		return fmt.Sprintf("Cluster: %s\n%s\n%s", clusterName, out, fmt.Sprintf(outputTruncatedMsg, truncatedErr.Limit))
+	case errors.Is(err, context.DeadlineExceeded):
+		e.log.Infof("kubectl %s command timed out", verb)
+		return fmt.Sprintf("Cluster: %s\n%s\n%s", clusterName, out, fmt.Sprintf(cmdTimeoutMsg, e.cfg.Settings.Execution.Timeout))
+	case errors.Is(err, context.Canceled):
+		e.log.Infof("kubectl %s command cancelled", verb)
+		return fmt.Sprintf("Cluster: %s\n%s\n%s", clusterName, out, cmdCancelledMsg)
+	default:
 		e.log.Error("Error in executing kubectl command: ", err)
 		return fmt.Sprintf("Cluster: %s\n%s", clusterName, out+err.Error())
 	}
-	return fmt.Sprintf("Cluster: %s\n%s", clusterName, out)
 }
 
-// TODO: Have a separate cli which runs bot commands
-func (e *DefaultExecutor) runNotifierCommand(args []string, clusterName string, isAuthChannel bool) string {
-	if !isAuthChannel {
-		return ""
+// runJob runs the kubectl command as a job, which can be cancelled by the users and is stopped after the configured timeout.
+func (e *DefaultExecutor) runJob(command string, args []string) (string, error) {
+	_, ctx, finish, err := e.jobs.start(command, e.Channel, e.cfg.Settings.Execution.Timeout, false)
+	if err != nil {
+		e.log.Errorf("while starting command: %s", err.Error())
+		return "", err
 	}
-	if len(args) < 2 {
-		return IncompleteCmdMsg
+	defer finish()
+	return e.runCmdFn(ctx, args)
+}
+
+// runCancelCommand cancels a given running kubectl command, or lists the running commands.
+func (e *DefaultExecutor) runCancelCommand(cmd parsedCommand) string {
+	clusterName := e.cfg.Settings.ClusterName
+	if len(cmd.Args) == 0 {
+		jobs := e.jobs.list(e.Channel)
+		if len(jobs) == 0 {
+			return fmt.Sprintf(noRunningCmdsMsg, clusterName)
+		}
+
+		return fmt.Sprintf("Running commands on cluster '%s':\n%s", clusterName, jobsTable(jobs))
 	}
 
-	var cmdVerb = args[1]
-	defer func() {
-		cmdToReport := fmt.Sprintf("%s %s", args[0], cmdVerb)
-		err := e.analyticsReporter.ReportCommand(e.Platform, cmdToReport)
-		if err != nil {
-			// TODO: Return error when the DefaultExecutor is refactored as a part of https://github.com/kubeshop/botkube/issues/589
-			e.log.Errorf("while reporting notifier command: %s", err.Error())
+	id := cmd.Args[0]
+	j, found := e.jobs.cancel(id, e.Channel, false)
+	if !found {
+		return fmt.Sprintf(cmdNotFoundMsg, id, clusterName)
+	}
+	e.log.Infof("Cancelled command %q with ID %s", j.Command, j.ID)
+	return fmt.Sprintf(cmdCancelSuccessMsg, j.ID, j.Command, clusterName)
+}
+
+// jobsTable returns a table with given jobs.
+func jobsTable(jobs []job) string {
+	buf := new(bytes.Buffer)
+	w := tabwriter.NewWriter(buf, 5, 0, 1, ' ', 0)
+	fmt.Fprintln(w, "ID\tCOMMAND\tRUNNING FOR")
+	for _, j := range jobs {
+		fmt.Fprintf(w, "%s\t%s\t%s\n", j.ID, j.Command, time.Since(j.StartedAt).Round(time.Second))
+	}
+	w.Flush()
+	return buf.String()
+}
+
+// hasStreamingFlags returns true if the kubectl command follows or watches the resources.
+func hasStreamingFlags(args []string) bool {
+	return len(withoutStreamingFlags(args)) != len(args)
+}
+
+// withoutStreamingFlags returns the kubectl command without the `--follow` and `--watch` flags.
+func withoutStreamingFlags(args []string) []string {
+	var out []string
+	for _, arg := range args {
+		if arg == AbbrFollowFlag.String() || strings.HasPrefix(arg, FollowFlag.String()) {
+			continue
 		}
-	}()
+		if arg == AbbrWatchFlag.String() || strings.HasPrefix(arg, WatchFlag.String()) {
+			continue
+		}
+		out = append(out, arg)
+	}
+	return out
+}
 
-	switch args[1] {
+// TODO: Have a separate cli which runs bot commands
+func (e *DefaultExecutor) runNotifierCommand(cmd parsedCommand) string {
+	clusterName := e.cfg.Settings.ClusterName
+	switch cmd.Command.Name {
 	case Start.String():
 		config.Notify = true
 		e.log.Info("Notifier enabled")
@@ -324,85 +732,119 @@ func (e *DefaultExecutor) runNotifierCommand(args []string, clusterName string,
 		}
 		return fmt.Sprintf("Showing config for cluster '%s'\n\n%s", clusterName, out)
 	}
-
-	cmdVerb = anonymizedInvalidVerb // prevent passing any personal information
-	return e.printDefaultMsg(e.Platform)
+	return unsupportedCmdMsg
 }
 
-// runFilterCommand to list, enable or disable filters
-func (e *DefaultExecutor) runFilterCommand(args []string, clusterName string, isAuthChannel bool) string {
-	if !isAuthChannel {
-		return ""
-	}
-	if len(args) < 2 {
-		return IncompleteCmdMsg
-	}
-
-	var cmdVerb = args[1]
-	defer func() {
-		cmdToReport := fmt.Sprintf("%s %s", args[0], cmdVerb)
-		err := e.analyticsReporter.ReportCommand(e.Platform, cmdToReport)
-		if err != nil {
-			// TODO: Return error when the DefaultExecutor is refactored as a part of https://github.com/kubeshop/botkube/issues/589
-			e.log.Errorf("while reporting filter command: %s", err.Error())
-		}
-	}()
-
-	switch args[1] {
-	case FilterList.String():
-		e.log.Debug("List filters")
-		return e.makeFiltersList()
+// runFiltersListCommand lists filters
+func (e *DefaultExecutor) runFiltersListCommand(_ parsedCommand) string {
+	e.log.Debug("List filters")
+	return e.makeFiltersList()
+}
 
-	// Enable filter
-	case FilterEnable.String():
-		if len(args) < 3 {
+// runFilterToggleCommand returns a function which enables or disables filters
+func (e *DefaultExecutor) runFilterToggleCommand(enabled bool) func(cmd parsedCommand) string {
+	return func(cmd parsedCommand) string {
+		if len(cmd.Args) < 1 {
 			return fmt.Sprintf(filterNameMissing, e.makeFiltersList())
 		}
-		e.log.Debug("Enable filters", args[2])
-		if err := e.filterEngine.SetFilter(args[2], true); err != nil {
+
+		name := cmd.Args[0]
+		e.log.Debugf("Set filter %q enabled: %v", name, enabled)
+		if err := e.filterEngine.SetFilter(name, enabled); err != nil {
 			return err.Error()
 		}
-		return fmt.Sprintf(filterEnabled, args[2], clusterName)
 
-	// Disable filter
-	case FilterDisable.String():
-		if len(args) < 3 {
-			return fmt.Sprintf(filterNameMissing, e.makeFiltersList())
+		if enabled {
+			return fmt.Sprintf(filterEnabled, name, e.cfg.Settings.ClusterName)
 		}
-		e.log.Debug("Disabled filters", args[2])
-		if err := e.filterEngine.SetFilter(args[2], false); err != nil {
-			return err.Error()
+		return fmt.Sprintf(filterDisabled, name, e.cfg.Settings.ClusterName)
+	}
+}
+
+// runInfoCommand lists the kubectl verbs, resources and aliases allowed in the current channel.
+func (e *DefaultExecutor) runInfoCommand(_ parsedCommand) string {
+	verbs, resources := map[string]bool{}, map[string]bool{}
+	for _, profile := range e.kubectlProfiles() {
+		for _, verb := range profile.Commands.Verbs {
+			verbs[verb] = true
+		}
+		for _, resource := range profile.Commands.Resources {
+			resources[resource] = true
 		}
-		return fmt.Sprintf(filterDisabled, args[2], clusterName)
 	}
 
-	cmdVerb = anonymizedInvalidVerb // prevent passing any personal information
-	return e.printDefaultMsg(e.Platform)
+	allowedVerbs := e.getSortedEnabledCommands("allowed verbs", verbs)
+	allowedResources := e.getSortedEnabledCommands("allowed resources", resources)
+	out := fmt.Sprintf("%s%s", allowedVerbs, allowedResources)
+
+	aliases := aliasesList(e.allowedAliases(verbs))
+	if aliases == "" {
+		return out
+	}
+	if !strings.HasSuffix(out, "\n") {
+		out += "\n"
+	}
+	return out + aliases
 }
 
-//runInfoCommand to list allowed commands
-func (e *DefaultExecutor) runInfoCommand(args []string, isAuthChannel bool) string {
-	if !isAuthChannel {
+// runAckCommand acknowledges the pending critical event.
+// Acknowledgement IDs are unique, so the events from other clusters are ignored outside the configured channel.
+// Outside the configured channels, e.g. in the escalation channels, only the users authorized with the user-level authorization can acknowledge the events.
+func (e *DefaultExecutor) runAckCommand(args []string, isAuthChannel bool) string {
+	if !isAuthChannel && !e.authorization().Enabled {
 		return ""
 	}
-	if len(args) > 1 && args[1] != string(infoList) {
-		return IncompleteCmdMsg
+
+	clusterName := e.cfg.Settings.ClusterName
+	if e.ackTracker == nil {
+		return e.replyIfAuthChannel(fmt.Sprintf(ackDisabledMsg, clusterName), isAuthChannel)
+	}
+	if len(args) < 1 {
+		return e.replyIfAuthChannel(ackIDMissingMsg, isAuthChannel)
 	}
 
-	err := e.analyticsReporter.ReportCommand(e.Platform, strings.Join(args, " "))
+	id := args[0]
+	pending, err := e.ackTracker.Ack(id)
 	if err != nil {
-		// TODO: Return error when the DefaultExecutor is refactored as a part of https://github.com/kubeshop/botkube/issues/589
-		e.log.Errorf("while reporting info command: %s", err.Error())
+		return e.replyIfAuthChannel(fmt.Sprintf(ackNotFoundMsg, id, clusterName), isAuthChannel)
 	}
 
-	clusterName := e.cfg.Settings.ClusterName
-	if len(args) > 3 && args[2] == ClusterFlag.String() && args[3] != clusterName {
-		return fmt.Sprintf(WrongClusterCmdMsg, args[3])
+	return fmt.Sprintf(ackSuccessMsg, id, eventObjectName(pending.Event), clusterName)
+}
+
+// runPendingEventsCommand lists events waiting for the acknowledgement
+func (e *DefaultExecutor) runPendingEventsCommand(_ parsedCommand) string {
+	if e.ackTracker == nil {
+		return fmt.Sprintf(ackDisabledMsg, e.cfg.Settings.ClusterName)
+	}
+	return e.makePendingEventsList(e.cfg.Settings.ClusterName)
+}
+
+func (e *DefaultExecutor) makePendingEventsList(clusterName string) string {
+	pending := e.ackTracker.Pending()
+	if len(pending) == 0 {
+		return fmt.Sprintf(noPendingEventsMsg, clusterName)
+	}
+
+	buf := new(bytes.Buffer)
+	w := tabwriter.NewWriter(buf, 5, 0, 1, ' ', 0)
+
+	fmt.Fprintln(w, "ID\tOBJECT\tREASON\tAGE\tESCALATED")
+	for _, p := range pending {
+		age := time.Since(p.CreatedAt).Truncate(time.Second)
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%v\n", p.ID, eventObjectName(p.Event), p.Event.Reason, age, p.Escalated)
 	}
 
-	allowedVerbs := e.getSortedEnabledCommands("allowed verbs", e.resMapping.AllowedKubectlVerbMap)
-	allowedResources := e.getSortedEnabledCommands("allowed resources", e.resMapping.AllowedKubectlResourceMap)
-	return fmt.Sprintf("%s%s", allowedVerbs, allowedResources)
+	w.Flush()
+	return buf.String()
+}
+
+// eventObjectName returns the object of the event in the `kind namespace/name` format.
+func eventObjectName(event events.Event) string {
+	if event.Namespace == "" {
+		return fmt.Sprintf("%s %s", event.Kind, event.Name)
+	}
+	return fmt.Sprintf("%s %s/%s", event.Kind, event.Namespace, event.Name)
 }
 
 // Use tabwriter to display string in tabular form
@@ -421,12 +863,17 @@ func (e *DefaultExecutor) makeFiltersList() string {
 }
 
 func (e *DefaultExecutor) findBotKubeVersion() (versions string) {
-	args := []string{"-c", fmt.Sprintf("%s version --short=true | grep Server", kubectlBinary)}
-	// Returns "Server Version: xxxx"
-	k8sVersion, err := e.runCmdFn("sh", args)
+	k8sVersion := "Server Version: Unknown\n"
+	out, err := e.runJob("version", []string{"version", "--short=true"})
 	if err != nil {
 		e.log.Warn(fmt.Sprintf("Failed to get Kubernetes version: %s", err.Error()))
-		k8sVersion = "Server Version: Unknown\n"
+	}
+	// Returns "Server Version: xxxx"
+	for _, line := range strings.Split(out, "\n") {
+		if strings.HasPrefix(line, "Server Version:") {
+			k8sVersion = line + "\n"
+			break
+		}
 	}
 
 	botkubeVersion := version.Short()
@@ -436,34 +883,6 @@ func (e *DefaultExecutor) findBotKubeVersion() (versions string) {
 	return fmt.Sprintf("K8s %sBotKube version: %s", k8sVersion, botkubeVersion)
 }
 
-func (e *DefaultExecutor) runVersionCommand(args []string, clusterName string) string {
-	err := e.analyticsReporter.ReportCommand(e.Platform, args[0])
-	if err != nil {
-		// TODO: Return error when the DefaultExecutor is refactored as a part of https://github.com/kubeshop/botkube/issues/589
-		e.log.Errorf("while reporting version command: %s", err.Error())
-	}
-
-	checkFlag := false
-	for _, arg := range args {
-		if checkFlag {
-			if arg != clusterName {
-				return ""
-			}
-			checkFlag = false
-			continue
-		}
-		if strings.HasPrefix(arg, ClusterFlag.String()) {
-			if arg == ClusterFlag.String() {
-				checkFlag = true
-			} else if strings.SplitAfterN(arg, ClusterFlag.String()+"=", 2)[1] != clusterName {
-				return ""
-			}
-			continue
-		}
-	}
-	return e.findBotKubeVersion()
-}
-
 const redactedSecretStr = "*** REDACTED ***"
 
 func (e *DefaultExecutor) showControllerConfig() (string, error) {
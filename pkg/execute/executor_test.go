@@ -1,10 +1,19 @@
 package execute
 
 import (
+	"context"
+	"fmt"
 	"testing"
 
 	"github.com/MakeNowJust/heredoc"
+	logtest "github.com/sirupsen/logrus/hooks/test"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kubeshop/botkube/pkg/ack"
+	"github.com/kubeshop/botkube/pkg/config"
+	"github.com/kubeshop/botkube/pkg/events"
 )
 
 func TestDefaultExecutor_getSortedEnabledCommands(t *testing.T) {
@@ -52,3 +61,323 @@ func TestDefaultExecutor_getSortedEnabledCommands(t *testing.T) {
 		})
 	}
 }
+
+func TestDefaultExecutor_AckCommands(t *testing.T) {
+	// given
+	logger, _ := logtest.NewNullLogger()
+	tracker := ack.NewTracker(logger, nil, config.Acknowledgement{Enabled: true})
+	event := events.Event{
+		TypeMeta: metav1.TypeMeta{Kind: "Node"},
+		Name:     "node-1",
+		Type:     config.ErrorEvent,
+		Reason:   "NodeNotReady",
+		Level:    config.Critical,
+	}
+	tracker.Track(&event)
+	require.NotEmpty(t, event.AckID)
+
+	cfg := config.Config{Settings: config.Settings{ClusterName: "dev"}}
+	factory := NewExecutorFactory(logger, nil, nil, cfg, nil, tracker, ResourceMapping{}, &fakeAnalyticsReporter{}, nil)
+	execute := func(isAuthChannel bool, msg string) string {
+		return factory.NewDefault(NewDefaultInput{
+			Platform:      config.SlackCommPlatformIntegration,
+			IsAuthChannel: isAuthChannel,
+			Message:       msg,
+		}).Execute()
+	}
+
+	// when
+	pending := execute(true, "events pending")
+
+	// then
+	assert.Contains(t, pending, event.AckID)
+	assert.Contains(t, pending, "Node node-1")
+
+	// when
+	unknownAuthChannel := execute(true, "ack unknown")
+	notConfiguredChannel := execute(false, "ack "+event.AckID)
+
+	// then
+	assert.Equal(t, "There is no pending event with ID 'unknown' on cluster 'dev'.", unknownAuthChannel)
+	assert.Empty(t, notConfiguredChannel, "events shouldn't be acknowledged from not configured channels")
+	assert.Contains(t, execute(true, "events pending"), event.AckID)
+
+	// when
+	acked := execute(true, "ack "+event.AckID)
+
+	// then
+	assert.Equal(t, fmt.Sprintf("Done. Event '%s' about Node node-1 on cluster 'dev' has been acknowledged.", event.AckID), acked)
+	assert.Equal(t, "There are no events waiting for the acknowledgement on cluster 'dev'.", execute(true, "events pending"))
+}
+
+func TestDefaultExecutor_AckCommandsWithUserAuthorization(t *testing.T) {
+	// given
+	logger, _ := logtest.NewNullLogger()
+	tracker := ack.NewTracker(logger, nil, config.Acknowledgement{Enabled: true})
+	event := events.Event{
+		TypeMeta: metav1.TypeMeta{Kind: "Node"},
+		Name:     "node-1",
+		Type:     config.ErrorEvent,
+		Reason:   "NodeNotReady",
+		Level:    config.Critical,
+	}
+	tracker.Track(&event)
+	require.NotEmpty(t, event.AckID)
+
+	cfg := config.Config{
+		Settings: config.Settings{ClusterName: "dev"},
+		Communications: config.IndexableMap[config.Communications]{
+			"default": {Slack: config.Slack{
+				Authorization: config.Authorization{
+					Enabled: true,
+					Rules: []config.AuthorizationRule{
+						{Verbs: []string{"ack"}, Users: []string{"U-ONCALL"}},
+					},
+				},
+			}},
+		},
+	}
+	factory := NewExecutorFactory(logger, nil, nil, cfg, nil, tracker, ResourceMapping{}, &fakeAnalyticsReporter{}, nil)
+	execute := func(user User, msg string) string {
+		return factory.NewDefault(NewDefaultInput{
+			Platform: config.SlackCommPlatformIntegration,
+			User:     user,
+			Message:  msg,
+		}).Execute()
+	}
+
+	// when
+	unknown := execute(User{ID: "U-ONCALL"}, "ack unknown")
+	denied := execute(User{ID: "U-OTHER"}, "ack "+event.AckID)
+	acked := execute(User{ID: "U-ONCALL"}, "ack "+event.AckID)
+
+	// then
+	assert.Empty(t, unknown, "unknown IDs may be tracked on other clusters")
+	assert.Empty(t, denied, "not authorized users shouldn't acknowledge events outside the configured channels")
+	assert.Equal(t, fmt.Sprintf("Done. Event '%s' about Node node-1 on cluster 'dev' has been acknowledged.", event.AckID), acked)
+}
+
+func TestDefaultExecutor_Execute(t *testing.T) {
+	cfg := config.Config{
+		Settings: config.Settings{ClusterName: "dev"},
+		Executors: config.IndexableMap[config.Executors]{
+			"kubectl": {Kubectl: config.Kubectl{
+				Enabled: true,
+				Commands: config.Commands{
+					Verbs:     []string{"get", "describe"},
+					Resources: []string{"pods", "deployments"},
+				},
+			}},
+		},
+	}
+	resMapping := ResourceMapping{
+		KindResourceMap:           map[string]string{"pod": "pods", "deployment": "deployments"},
+		AllowedKubectlResourceMap: map[string]bool{"pods": true, "deployments": true},
+		AllowedKubectlVerbMap:     map[string]bool{"get": true, "describe": true},
+	}
+
+	testCases := []struct {
+		Name               string
+		Message            string
+		IsAuthChannel      bool
+		ExpectedOutput     string
+		ExpectedKubectlCmd []string
+	}{
+		{
+			Name:               "kubectl command with quoted argument",
+			Message:            `get pods -l "app in (foo, bar)"`,
+			IsAuthChannel:      true,
+			ExpectedOutput:     "Cluster: dev\nout",
+			ExpectedKubectlCmd: []string{"get", "pods", "-l", "app in (foo, bar)"},
+		},
+		{
+			Name:               "kubectl command with matching cluster name in other channel",
+			Message:            "get deployment --cluster-name='dev'",
+			ExpectedOutput:     "Cluster: dev\nout",
+			ExpectedKubectlCmd: []string{"get", "deployment"},
+		},
+		{
+			Name:           "kubectl command for other cluster",
+			Message:        "get pods --cluster-name prod",
+			IsAuthChannel:  true,
+			ExpectedOutput: "",
+		},
+		{
+			Name:           "BotKube command for other cluster",
+			Message:        "notifier status --cluster-name=prod",
+			IsAuthChannel:  true,
+			ExpectedOutput: "",
+		},
+		{
+			Name:           "Commands list for unknown cluster",
+			Message:        "commands list --cluster-name=prod",
+			IsAuthChannel:  true,
+			ExpectedOutput: "Sorry, the admin hasn't configured me to do that for the cluster 'prod'.",
+		},
+		{
+			Name:           "Commands list for unknown cluster in other channel",
+			Message:        "commands list --cluster-name=prod",
+			ExpectedOutput: "",
+		},
+		{
+			Name:           "BotKube command in other channel",
+			Message:        "notifier status",
+			ExpectedOutput: "",
+		},
+		{
+			Name:           "Mistyped kubectl resource",
+			Message:        "get pdos",
+			IsAuthChannel:  true,
+			ExpectedOutput: "Command not supported. Did you mean `get pods`? Please run `@BotKube help` to see supported commands.",
+		},
+		{
+			Name:           "Mistyped verb",
+			Message:        "filter list",
+			IsAuthChannel:  true,
+			ExpectedOutput: "Command not supported. Did you mean `filters`? Please run `@BotKube help` to see supported commands.",
+		},
+		{
+			Name:           "Mistyped subcommand",
+			Message:        "notifier strat",
+			IsAuthChannel:  true,
+			ExpectedOutput: "Command not supported. Did you mean `notifier start`? Please run `@BotKube help` to see supported commands.",
+		},
+		{
+			Name:           "Unknown command",
+			Message:        "foo",
+			IsAuthChannel:  true,
+			ExpectedOutput: "Command not supported. Please run `@BotKube help` to see supported commands.",
+		},
+		{
+			Name:           "Unknown command in other channel",
+			Message:        "foo",
+			ExpectedOutput: "",
+		},
+		{
+			Name:           "Missing closing quote",
+			Message:        `get pods -l "app=foo`,
+			IsAuthChannel:  true,
+			ExpectedOutput: "Invalid command: missing closing quote (\"). Please run `@BotKube help` to see command options.",
+		},
+		{
+			Name:          "Command help",
+			Message:       "help filters",
+			IsAuthChannel: true,
+			ExpectedOutput: heredoc.Doc(`
+				Manages filters of the events.
+
+				Usage:
+				  filters <list|enable|disable> [flags]
+
+				Available commands:
+				  list                   Lists filters.
+				  enable <filter-name>   Enables a given filter.
+				  disable <filter-name>  Disables a given filter.
+
+				Flags:
+				  --cluster-name string  Runs the command only on a given cluster.
+				  --help                 Shows help for the command.
+			`),
+		},
+		{
+			Name:          "Help flag",
+			Message:       "notifier start --help",
+			IsAuthChannel: true,
+			ExpectedOutput: heredoc.Doc(`
+				Starts sending notifications.
+
+				Usage:
+				  notifier start [flags]
+
+				Flags:
+				  --cluster-name string  Runs the command only on a given cluster.
+				  --help                 Shows help for the command.
+			`),
+		},
+		{
+			Name:          "Incomplete command",
+			Message:       "events",
+			IsAuthChannel: true,
+			ExpectedOutput: heredoc.Doc(`
+				You missed to pass options for the command.
+
+				Manages events waiting for the acknowledgement.
+
+				Usage:
+				  events <pending> [flags]
+
+				Available commands:
+				  pending  Lists events waiting for the acknowledgement.
+
+				Flags:
+				  --cluster-name string  Runs the command only on a given cluster.
+				  --help                 Shows help for the command.
+			`),
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// given
+			var kubectlCmd []string
+			runCmdFn := func(_ context.Context, args []string) (string, error) {
+				kubectlCmd = args
+				return "out", nil
+			}
+			logger, _ := logtest.NewNullLogger()
+			factory := NewExecutorFactory(logger, runCmdFn, nil, cfg, nil, nil, resMapping, &fakeAnalyticsReporter{}, nil)
+
+			// when
+			out := factory.NewDefault(NewDefaultInput{
+				Platform:         config.SlackCommPlatformIntegration,
+				IsAuthChannel:    testCase.IsAuthChannel,
+				ExecutorBindings: []string{"kubectl"},
+				Message:          testCase.Message,
+			}).Execute()
+
+			// then
+			assert.Equal(t, testCase.ExpectedOutput, out)
+			assert.Equal(t, testCase.ExpectedKubectlCmd, kubectlCmd)
+		})
+	}
+}
+
+func TestDefaultExecutor_RootHelp(t *testing.T) {
+	// given
+	logger, _ := logtest.NewNullLogger()
+	factory := NewExecutorFactory(logger, nil, nil, config.Config{}, nil, nil, ResourceMapping{}, &fakeAnalyticsReporter{}, nil)
+	expected := heredoc.Doc(`
+		BotKube commands:
+		  ack <event-id>                           Acknowledges the critical event, so it is not escalated.
+		  approve <request-id>                     Runs a kubectl command waiting for the confirmation or approval.
+		  cancel [command-id]                      Cancels a kubectl command started in this channel, or lists the running ones.
+		  commands [list]                          Lists allowed kubectl verbs, resources and aliases.
+		  events <pending>                         Manages events waiting for the acknowledgement.
+		  filters <list|enable|disable>            Manages filters of the events.
+		  help [command]                           Shows help for BotKube commands.
+		  notifier <start|stop|status|showconfig>  Manages notifications.
+		  ping                                     Checks if BotKube is running on the cluster.
+		  reject <request-id>                      Rejects a kubectl command waiting for the confirmation or approval.
+		  stop [session-id]                        Stops a streaming session started in this channel, or lists the running ones.
+		  version                                  Shows BotKube and Kubernetes versions.
+
+		Run ` + "`help <command>`" + ` to see details of a given command.
+
+		Global flags:
+		  --cluster-name string  Runs the command only on a given cluster.
+		  --help                 Shows help for the command.
+	`)
+
+	// when
+	help := factory.NewDefault(NewDefaultInput{Platform: config.SlackCommPlatformIntegration, IsAuthChannel: true, Message: "help"}).Execute()
+	empty := factory.NewDefault(NewDefaultInput{Platform: config.SlackCommPlatformIntegration, IsAuthChannel: true, Message: ""}).Execute()
+
+	// then
+	assert.Equal(t, expected, help)
+	assert.Equal(t, expected, empty)
+}
+
+type fakeAnalyticsReporter struct{}
+
+func (f *fakeAnalyticsReporter) ReportCommand(_ config.CommPlatformIntegration, _ string) error {
+	return nil
+}
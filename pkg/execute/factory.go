@@ -3,6 +3,8 @@ package execute
 import (
 	"github.com/sirupsen/logrus"
 
+	"github.com/kubeshop/botkube/pkg/ack"
+	"github.com/kubeshop/botkube/pkg/audit"
 	"github.com/kubeshop/botkube/pkg/config"
 	"github.com/kubeshop/botkube/pkg/filterengine"
 )
@@ -11,15 +13,22 @@ import (
 type DefaultExecutorFactory struct {
 	log               logrus.FieldLogger
 	runCmdFn          CommandRunnerFunc
+	streamCmdFn       CommandStreamerFunc
 	cfg               config.Config
 	filterEngine      filterengine.FilterEngine
+	ackTracker        *ack.Tracker
 	resMapping        ResourceMapping
 	analyticsReporter AnalyticsReporter
+	auditor           Auditor
+	jobs              *jobTracker
+	approvals         *approvalTracker
 }
 
 // Executor is an interface for processes to execute commands
 type Executor interface {
 	Execute() string
+	// Actions returns the interactive elements for the last response, e.g. buttons. They can be ignored if they are not supported.
+	Actions() []Action
 }
 
 // AnalyticsReporter defines a reporter that collects analytics data.
@@ -28,37 +37,79 @@ type AnalyticsReporter interface {
 	ReportCommand(platform config.CommPlatformIntegration, command string) error
 }
 
+// Auditor records the audit trail of the executed commands.
+type Auditor interface {
+	// AuditCommand records a given audit record. It must not block.
+	AuditCommand(event audit.Event)
+}
+
 // NewExecutorFactory creates new DefaultExecutorFactory.
 func NewExecutorFactory(
 	log logrus.FieldLogger,
 	runCmdFn CommandRunnerFunc,
+	streamCmdFn CommandStreamerFunc,
 	cfg config.Config,
 	filterEngine filterengine.FilterEngine,
+	ackTracker *ack.Tracker,
 	resMapping ResourceMapping,
 	analyticsReporter AnalyticsReporter,
+	auditor Auditor,
 ) *DefaultExecutorFactory {
 	return &DefaultExecutorFactory{
 		log:               log,
 		runCmdFn:          runCmdFn,
+		streamCmdFn:       streamCmdFn,
 		cfg:               cfg,
 		filterEngine:      filterEngine,
+		ackTracker:        ackTracker,
 		resMapping:        resMapping,
 		analyticsReporter: analyticsReporter,
+		auditor:           auditor,
+		jobs:              newJobTracker(),
+		approvals:         newApprovalTracker(),
 	}
 }
 
+// NewDefaultInput contains details of the message for which the Default Executor is created.
+type NewDefaultInput struct {
+	Platform config.CommPlatformIntegration
+	// IsAuthChannel is true if the message was posted in one of the configured channels.
+	IsAuthChannel bool
+	// IsDirectMessage is true if the message was sent directly to BotKube.
+	IsDirectMessage bool
+	// ExecutorBindings are names of the executors bound to the channel in which the message was posted.
+	ExecutorBindings []string
+	User             User
+	Message          string
+	// OutputStreamer posts the output of the `--follow` and `--watch` commands. If it's nil, these flags are ignored.
+	OutputStreamer OutputStreamer
+	// Channel is the ID of the channel in which the message was posted. It's used in the audit records.
+	Channel string
+}
+
 // NewDefault creates new Default Executor.
-func (f *DefaultExecutorFactory) NewDefault(platform config.CommPlatformIntegration, isAuthChannel bool, message string) Executor {
+func (f *DefaultExecutorFactory) NewDefault(in NewDefaultInput) Executor {
 	return &DefaultExecutor{
 		log:               f.log,
 		runCmdFn:          f.runCmdFn,
+		streamCmdFn:       f.streamCmdFn,
 		cfg:               f.cfg,
 		resMapping:        f.resMapping,
 		analyticsReporter: f.analyticsReporter,
+		auditor:           f.auditor,
+		jobs:              f.jobs,
+		approvals:         f.approvals,
+
+		filterEngine:    f.filterEngine,
+		ackTracker:      f.ackTracker,
+		IsAuthChannel:   in.IsAuthChannel,
+		IsDirectMessage: in.IsDirectMessage,
+		User:            in.User,
+		Channel:         in.Channel,
+		Message:         in.Message,
+		Platform:        in.Platform,
 
-		filterEngine:  f.filterEngine,
-		IsAuthChannel: isAuthChannel,
-		Message:       message,
-		Platform:      platform,
+		executorBindings: in.ExecutorBindings,
+		outputStreamer:   in.OutputStreamer,
 	}
 }
@@ -0,0 +1,126 @@
+package execute
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+)
+
+// job is a running kubectl command.
+type job struct {
+	ID        string
+	Command   string
+	StartedAt time.Time
+	// Streaming is true for the sessions which stream the command output, e.g. `logs -f`.
+	Streaming bool
+	// Channel is the ID of the channel in which the job was started. The job can be listed and cancelled only there.
+	Channel string
+
+	seq    int
+	cancel context.CancelFunc
+}
+
+// jobTracker tracks the running kubectl commands, so they can be listed and cancelled.
+// IDs are random, so the jobs don't collide between BotKube instances running in the same channel.
+type jobTracker struct {
+	idFn func() (string, error)
+
+	mu      sync.Mutex
+	lastSeq int
+	jobs    map[string]*job
+}
+
+func newJobTracker() *jobTracker {
+	return &jobTracker{
+		idFn: randomID,
+		jobs: map[string]*job{},
+	}
+}
+
+// start registers a new job started in a given channel and returns its ID. The returned context is done when the job is cancelled
+// or the timeout passes. Zero timeout means no timeout. The returned function must be called when the job finishes.
+func (t *jobTracker) start(command, channel string, timeout time.Duration, streaming bool) (string, context.Context, func(), error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	id, err := t.newID()
+	if err != nil {
+		return "", nil, nil, fmt.Errorf("while generating ID: %w", err)
+	}
+
+	var (
+		ctx    context.Context
+		cancel context.CancelFunc
+	)
+	if timeout > 0 {
+		ctx, cancel = context.WithTimeout(context.Background(), timeout)
+	} else {
+		ctx, cancel = context.WithCancel(context.Background())
+	}
+
+	t.lastSeq++
+	t.jobs[id] = &job{
+		ID:        id,
+		Command:   command,
+		StartedAt: time.Now(),
+		Streaming: streaming,
+		Channel:   channel,
+		seq:       t.lastSeq,
+		cancel:    cancel,
+	}
+
+	return id, ctx, func() {
+		t.mu.Lock()
+		defer t.mu.Unlock()
+		delete(t.jobs, id)
+		cancel()
+	}, nil
+}
+
+// cancel cancels the job with a given ID started in a given channel. If streamingOnly is true, other jobs than the streaming sessions are ignored.
+// It returns false if there is no such job.
+func (t *jobTracker) cancel(id, channel string, streamingOnly bool) (job, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	j, found := t.jobs[id]
+	if !found || j.Channel != channel || (streamingOnly && !j.Streaming) {
+		return job{}, false
+	}
+	delete(t.jobs, id)
+	j.cancel()
+	return *j, true
+}
+
+// list returns the jobs running in a given channel, sorted from the oldest one.
+func (t *jobTracker) list(channel string) []job {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	var out []job
+	for _, j := range t.jobs {
+		if j.Channel == channel {
+			out = append(out, *j)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool {
+		return out[i].seq < out[j].seq
+	})
+	return out
+}
+
+// newID returns a unique ID. Must be called with the lock held.
+func (t *jobTracker) newID() (string, error) {
+	for i := 0; i < maxIDGenerateTries; i++ {
+		id, err := t.idFn()
+		if err != nil {
+			return "", err
+		}
+		if _, exists := t.jobs[id]; !exists {
+			return id, nil
+		}
+	}
+	return "", fmt.Errorf("cannot generate unique ID in %d tries", maxIDGenerateTries)
+}
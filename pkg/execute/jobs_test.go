@@ -0,0 +1,133 @@
+package execute
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	logtest "github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kubeshop/botkube/pkg/config"
+)
+
+func TestDefaultExecutor_CommandLimits(t *testing.T) {
+	resMapping := ResourceMapping{
+		AllowedKubectlResourceMap: map[string]bool{"pods": true},
+		AllowedKubectlVerbMap:     map[string]bool{"logs": true},
+	}
+
+	testCases := []struct {
+		Name           string
+		RunCmdFn       CommandRunnerFunc
+		ExpectedOutput string
+	}{
+		{
+			Name: "Timeout",
+			RunCmdFn: func(ctx context.Context, _ []string) (string, error) {
+				<-ctx.Done()
+				return "first line", ctx.Err()
+			},
+			ExpectedOutput: "Cluster: prod\nfirst line\nSorry, the command didn't finish in 10ms and has been stopped.",
+		},
+		{
+			Name: "Truncated output",
+			RunCmdFn: func(_ context.Context, _ []string) (string, error) {
+				return "first", &outputTruncatedError{Limit: 5}
+			},
+			ExpectedOutput: "Cluster: prod\nfirst\nThe output has been truncated, as it exceeded 5 bytes.",
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// given
+			cfg := newJobsTestConfig(10 * time.Millisecond)
+			logger, _ := logtest.NewNullLogger()
+			factory := NewExecutorFactory(logger, testCase.RunCmdFn, nil, cfg, nil, nil, resMapping, &fakeAnalyticsReporter{}, nil)
+
+			// when
+			out := newJobsTestExecutor(factory, "logs my-pod").Execute()
+
+			// then
+			assert.Equal(t, testCase.ExpectedOutput, out)
+		})
+	}
+}
+
+func TestDefaultExecutor_CancelCommand(t *testing.T) {
+	// given
+	resMapping := ResourceMapping{
+		AllowedKubectlResourceMap: map[string]bool{"pods": true},
+		AllowedKubectlVerbMap:     map[string]bool{"logs": true},
+	}
+	started := make(chan struct{})
+	runCmdFn := func(ctx context.Context, _ []string) (string, error) {
+		close(started)
+		<-ctx.Done()
+		return "", ctx.Err()
+	}
+	logger, _ := logtest.NewNullLogger()
+	factory := NewExecutorFactory(logger, runCmdFn, nil, newJobsTestConfig(0), nil, nil, resMapping, &fakeAnalyticsReporter{}, nil)
+	factory.jobs.idFn = func() (string, error) { return "a1b2c3", nil }
+
+	result := make(chan string, 1)
+	go func() {
+		result <- newJobsTestExecutor(factory, "logs my-pod").Execute()
+	}()
+	<-started
+
+	// when
+	list := newJobsTestExecutor(factory, "cancel").Execute()
+	notFound := newJobsTestExecutor(factory, "cancel d4e5f6").Execute()
+	otherChannelList := newJobsTestExecutorInChannel(factory, "C002", "cancel").Execute()
+	otherChannelCancel := newJobsTestExecutorInChannel(factory, "C002", "cancel a1b2c3").Execute()
+	cancelled := newJobsTestExecutor(factory, "cancel a1b2c3").Execute()
+
+	// then
+	assert.Equal(t, "Running commands on cluster 'prod':\nID     COMMAND     RUNNING FOR\na1b2c3 logs my-pod 0s\n", list)
+	assert.Equal(t, "There is no running command with ID 'd4e5f6' on cluster 'prod'.", notFound)
+	assert.Equal(t, "There are no running commands on cluster 'prod'.", otherChannelList, "commands should be listed only in the channel which started them")
+	assert.Equal(t, "There is no running command with ID 'a1b2c3' on cluster 'prod'.", otherChannelCancel, "commands should be cancelled only from the channel which started them")
+	assert.Equal(t, "Done. Command 'a1b2c3' (`logs my-pod`) on cluster 'prod' has been cancelled.", cancelled)
+
+	select {
+	case out := <-result:
+		assert.Equal(t, "Cluster: prod\n\nThe command has been cancelled.", out)
+	case <-time.After(time.Second):
+		require.Fail(t, "command was not cancelled")
+	}
+	assert.Equal(t, "There are no running commands on cluster 'prod'.", newJobsTestExecutor(factory, "cancel").Execute())
+}
+
+func newJobsTestConfig(timeout time.Duration) config.Config {
+	return config.Config{
+		Settings: config.Settings{
+			ClusterName: "prod",
+			Execution:   config.CommandExecution{Timeout: timeout},
+		},
+		Executors: config.IndexableMap[config.Executors]{
+			"kubectl": {Kubectl: config.Kubectl{
+				Enabled: true,
+				Commands: config.Commands{
+					Verbs:     []string{"logs"},
+					Resources: []string{"pods"},
+				},
+			}},
+		},
+	}
+}
+
+func newJobsTestExecutor(factory *DefaultExecutorFactory, msg string) Executor {
+	return newJobsTestExecutorInChannel(factory, "C001", msg)
+}
+
+func newJobsTestExecutorInChannel(factory *DefaultExecutorFactory, channel, msg string) Executor {
+	return factory.NewDefault(NewDefaultInput{
+		Platform:         config.SlackCommPlatformIntegration,
+		IsAuthChannel:    true,
+		ExecutorBindings: []string{"kubectl"},
+		Message:          msg,
+		Channel:          channel,
+	})
+}
@@ -0,0 +1,248 @@
+package execute
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/kubeshop/botkube/pkg/config"
+	"github.com/kubeshop/botkube/pkg/utils"
+)
+
+const defaultKubectlNamespace = "default"
+
+// errNoKubectlExecutors is returned when there are no kubectl executors available in the current channel.
+var errNoKubectlExecutors = errors.New("no kubectl executors available")
+
+var (
+	// kubectlVerbsWithSubcommands contains verbs which expect a subcommand before the resource, e.g. `rollout restart deployment/app`.
+	kubectlVerbsWithSubcommands = map[string]bool{
+		"rollout": true,
+	}
+	// verbsWithoutNamespace contains verbs which don't operate on the namespaced objects.
+	verbsWithoutNamespace = map[string]bool{
+		"api-resources": true,
+		"api-versions":  true,
+		"auth":          true,
+		"cluster-info":  true,
+		"cordon":        true,
+		"drain":         true,
+		"uncordon":      true,
+	}
+)
+
+// kubectlProfile is a kubectl executor which can be used in a given channel.
+type kubectlProfile struct {
+	Name string
+	config.Kubectl
+}
+
+// kubectlCommand contains the parts of the kubectl command which are checked against the executor permissions.
+type kubectlCommand struct {
+	Verb          string
+	Resources     []string
+	Namespace     string
+	AllNamespaces bool
+	Namespaced    bool
+}
+
+// permissionError describes the permission missing to run a given kubectl command.
+type permissionError struct {
+	// rank orders the missing permissions, so the most relevant one is reported: verb < resource < namespace.
+	rank       int
+	permission string
+}
+
+func (e *permissionError) Error() string {
+	return e.permission
+}
+
+// kubectlProfiles returns kubectl executors which can be used in the current channel.
+// In the configured channels, the executors bound to the channel are used in the binding order.
+// In other channels, only the executors without restricted access are used.
+func (e *DefaultExecutor) kubectlProfiles() []kubectlProfile {
+	names := e.executorBindings
+	if !e.IsAuthChannel {
+		names = nil
+		for name, executor := range e.cfg.Executors {
+			if !executor.Kubectl.RestrictAccess {
+				names = append(names, name)
+			}
+		}
+		sort.Strings(names)
+	}
+
+	var out []kubectlProfile
+	for _, name := range names {
+		executor, found := e.cfg.Executors[name]
+		if !found || !executor.Kubectl.Enabled {
+			continue
+		}
+		out = append(out, kubectlProfile{Name: name, Kubectl: executor.Kubectl})
+	}
+	return out
+}
+
+// authorizeKubectlCommand returns the first kubectl executor available in the current channel which allows running a given command.
+// If none of them does, the most relevant missing permission is returned.
+func (e *DefaultExecutor) authorizeKubectlCommand(cmd kubectlCommand) (kubectlProfile, error) {
+	profiles := e.kubectlProfiles()
+	if len(profiles) == 0 {
+		return kubectlProfile{}, errNoKubectlExecutors
+	}
+
+	var missing *permissionError
+	for _, profile := range profiles {
+		err := profile.checkPermissions(cmd)
+		if err == nil {
+			return profile, nil
+		}
+		if missing == nil || err.rank > missing.rank {
+			missing = err
+		}
+	}
+	return kubectlProfile{}, missing
+}
+
+// parseKubectlCommand returns the parts of a given kubectl command which are checked against the executor permissions.
+func (e *DefaultExecutor) parseKubectlCommand(args []string) (kubectlCommand, error) {
+	parsed, err := parseKubectlArgs(args)
+	if err != nil {
+		return kubectlCommand{}, err
+	}
+
+	cmd := kubectlCommand{
+		Verb:          args[0],
+		Namespaced:    !verbsWithoutNamespace[args[0]],
+		Namespace:     parsed.Namespace,
+		AllNamespaces: parsed.AllNamespaces,
+	}
+
+	// resources of the debug commands, e.g. `logs my-pod`, cannot be determined
+	if validDebugCommands[cmd.Verb] {
+		return cmd, nil
+	}
+
+	cmd.Resources = e.kubectlResources(parsed)
+	if len(cmd.Resources) == 0 {
+		return cmd, nil
+	}
+	cmd.Namespaced = false
+	for _, resource := range cmd.Resources {
+		if !e.resMapping.ClusterScopedResourceMap[resource] {
+			cmd.Namespaced = true
+		}
+	}
+	return cmd, nil
+}
+
+// kubectlResources returns all resources referenced in a given kubectl command,
+// e.g. `pods` and `secrets` for both `get pods,secrets` and `get pods/app secrets/token`.
+func (e *DefaultExecutor) kubectlResources(parsed kubectlArgs) []string {
+	args := append(append([]string{}, parsed.Path...), parsed.Args...)
+	idx, found := kubectlResourceIndex(args)
+	if !found {
+		return nil
+	}
+
+	var out []string
+	for i, arg := range args[idx:] {
+		// the resource types are followed by the names, unless all resources are specified as `type/name`
+		if i > 0 && !strings.Contains(arg, "/") {
+			continue
+		}
+		for _, item := range strings.Split(arg, ",") {
+			resource, _, _ := strings.Cut(item, "/")
+			out = append(out, e.normalizeResource(resource))
+		}
+	}
+	return out
+}
+
+// normalizeResource returns the resource name for a given resource, kind or short name.
+func (e *DefaultExecutor) normalizeResource(in string) string {
+	if e.resMapping.AllowedKubectlResourceMap[in] {
+		return in
+	}
+	lower := strings.ToLower(in)
+	if resource, found := e.resMapping.KindResourceMap[lower]; found {
+		return resource
+	}
+	if resource, found := e.resMapping.ShortnameResourceMap[lower]; found {
+		return resource
+	}
+	return lower
+}
+
+// checkPermissions checks if the executor allows running a given command.
+func (p kubectlProfile) checkPermissions(cmd kubectlCommand) *permissionError {
+	if !utils.Contains(p.Commands.Verbs, cmd.Verb) {
+		return &permissionError{rank: 0, permission: fmt.Sprintf("verb `%s`", cmd.Verb)}
+	}
+	for _, resource := range cmd.Resources {
+		if !utils.Contains(p.Commands.Resources, resource) {
+			return &permissionError{rank: 1, permission: fmt.Sprintf("resource `%s`", resource)}
+		}
+	}
+	if !cmd.Namespaced {
+		return nil
+	}
+
+	if cmd.AllNamespaces {
+		if !p.allowsAllNamespaces() {
+			return &permissionError{rank: 2, permission: "all namespaces"}
+		}
+		return nil
+	}
+	namespace := p.namespaceFor(cmd)
+	if !p.allowsNamespace(namespace) {
+		return &permissionError{rank: 2, permission: fmt.Sprintf("namespace `%s`", namespace)}
+	}
+	return nil
+}
+
+// namespaceFor returns the namespace in which a given command is run.
+func (p kubectlProfile) namespaceFor(cmd kubectlCommand) string {
+	if cmd.Namespace != "" {
+		return cmd.Namespace
+	}
+	if p.DefaultNamespace != "" {
+		return p.DefaultNamespace
+	}
+	return defaultKubectlNamespace
+}
+
+func (p kubectlProfile) includesAllNamespaces() bool {
+	include := p.Namespaces.Include
+	return len(include) == 0 || (len(include) == 1 && include[0] == "all")
+}
+
+func (p kubectlProfile) allowsAllNamespaces() bool {
+	return p.includesAllNamespaces() && len(p.Namespaces.Ignore) == 0
+}
+
+func (p kubectlProfile) allowsNamespace(namespace string) bool {
+	if matchesAnyNamespace(p.Namespaces.Ignore, namespace) {
+		return false
+	}
+	return p.includesAllNamespaces() || matchesAnyNamespace(p.Namespaces.Include, namespace)
+}
+
+// kubectlResourceIndex returns the index of the argument which specifies resources of a given kubectl command.
+func kubectlResourceIndex(args []string) (int, bool) {
+	idx := 1
+	if kubectlVerbsWithSubcommands[args[0]] {
+		idx = 2
+	}
+	return idx, idx < len(args)
+}
+
+func matchesAnyNamespace(patterns []string, namespace string) bool {
+	for _, pattern := range patterns {
+		if utils.NamespaceMatches(pattern, namespace) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,230 @@
+package execute
+
+import (
+	"context"
+	"testing"
+
+	logtest "github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kubeshop/botkube/pkg/config"
+)
+
+func TestDefaultExecutor_KubectlPermissions(t *testing.T) {
+	cfg := config.Config{
+		Settings: config.Settings{ClusterName: "prod"},
+		Executors: config.IndexableMap[config.Executors]{
+			"kubectl-read-only": {Kubectl: config.Kubectl{
+				Enabled:          true,
+				RestrictAccess:   true,
+				DefaultNamespace: "dev-app",
+				Namespaces:       config.Namespaces{Include: []string{"dev-*"}},
+				Commands: config.Commands{
+					Verbs:     []string{"get", "logs", "describe"},
+					Resources: []string{"pods", "deployments"},
+				},
+			}},
+			"kubectl-sre": {Kubectl: config.Kubectl{
+				Enabled:        true,
+				RestrictAccess: true,
+				Namespaces:     config.Namespaces{Include: []string{"all"}, Ignore: []string{"kube-system"}},
+				Commands: config.Commands{
+					Verbs:     []string{"get", "logs", "describe", "rollout", "cordon"},
+					Resources: []string{"pods", "deployments", "nodes"},
+				},
+			}},
+		},
+	}
+	resMapping := ResourceMapping{
+		KindResourceMap:           map[string]string{"pod": "pods", "deployment": "deployments", "node": "nodes"},
+		ShortnameResourceMap:      map[string]string{"po": "pods", "deploy": "deployments", "no": "nodes"},
+		AllowedKubectlResourceMap: map[string]bool{"pods": true, "deployments": true, "nodes": true, "secrets": true},
+		AllowedKubectlVerbMap:     map[string]bool{"get": true, "logs": true, "describe": true, "rollout": true, "cordon": true},
+		ClusterScopedResourceMap:  map[string]bool{"nodes": true},
+	}
+	devBindings := []string{"kubectl-read-only"}
+	sreBindings := []string{"kubectl-read-only", "kubectl-sre"}
+
+	testCases := []struct {
+		Name               string
+		Message            string
+		IsAuthChannel      bool
+		ExecutorBindings   []string
+		ExpectedOutput     string
+		ExpectedKubectlCmd []string
+	}{
+		{
+			Name:               "Default namespace of the executor",
+			Message:            "get pods",
+			IsAuthChannel:      true,
+			ExecutorBindings:   devBindings,
+			ExpectedOutput:     "Cluster: prod\nout",
+			ExpectedKubectlCmd: []string{"-n", "dev-app", "get", "pods"},
+		},
+		{
+			Name:               "Allowed namespace",
+			Message:            "logs my-pod -n dev-api",
+			IsAuthChannel:      true,
+			ExecutorBindings:   devBindings,
+			ExpectedOutput:     "Cluster: prod\nout",
+			ExpectedKubectlCmd: []string{"logs", "my-pod", "-n", "dev-api"},
+		},
+		{
+			Name:             "Forbidden namespace",
+			Message:          "get deploy --namespace=prod",
+			IsAuthChannel:    true,
+			ExecutorBindings: devBindings,
+			ExpectedOutput:   "Sorry, this channel doesn't have permission to run this command on cluster 'prod'. Missing permission: namespace `prod`.",
+		},
+		{
+			Name:             "All namespaces",
+			Message:          "get pods -A",
+			IsAuthChannel:    true,
+			ExecutorBindings: devBindings,
+			ExpectedOutput:   "Sorry, this channel doesn't have permission to run this command on cluster 'prod'. Missing permission: all namespaces.",
+		},
+		{
+			Name:             "Forbidden verb",
+			Message:          "rollout restart deployment/api -n dev-api",
+			IsAuthChannel:    true,
+			ExecutorBindings: devBindings,
+			ExpectedOutput:   "Sorry, this channel doesn't have permission to run this command on cluster 'prod'. Missing permission: verb `rollout`.",
+		},
+		{
+			Name:             "Forbidden resource",
+			Message:          "get nodes",
+			IsAuthChannel:    true,
+			ExecutorBindings: devBindings,
+			ExpectedOutput:   "Sorry, this channel doesn't have permission to run this command on cluster 'prod'. Missing permission: resource `nodes`.",
+		},
+		{
+			Name:             "All namespaces with numeric value",
+			Message:          "get pods --all-namespaces=1",
+			IsAuthChannel:    true,
+			ExecutorBindings: devBindings,
+			ExpectedOutput:   "Sorry, this channel doesn't have permission to run this command on cluster 'prod'. Missing permission: all namespaces.",
+		},
+		{
+			Name:             "All namespaces with capitalized value",
+			Message:          "get pods --all-namespaces=True",
+			IsAuthChannel:    true,
+			ExecutorBindings: devBindings,
+			ExpectedOutput:   "Sorry, this channel doesn't have permission to run this command on cluster 'prod'. Missing permission: all namespaces.",
+		},
+		{
+			Name:             "All namespaces shorthand with value",
+			Message:          "get pods -A=true",
+			IsAuthChannel:    true,
+			ExecutorBindings: devBindings,
+			ExpectedOutput:   "Sorry, this channel doesn't have permission to run this command on cluster 'prod'. Missing permission: all namespaces.",
+		},
+		{
+			Name:             "All namespaces in combined shorthands",
+			Message:          "get pods -Ao wide",
+			IsAuthChannel:    true,
+			ExecutorBindings: devBindings,
+			ExpectedOutput:   "Sorry, this channel doesn't have permission to run this command on cluster 'prod'. Missing permission: all namespaces.",
+		},
+		{
+			Name:             "Namespace in combined shorthands",
+			Message:          "get pods -Rn prod",
+			IsAuthChannel:    true,
+			ExecutorBindings: devBindings,
+			ExpectedOutput:   "Sorry, this channel doesn't have permission to run this command on cluster 'prod'. Missing permission: namespace `prod`.",
+		},
+		{
+			Name:             "Namespace value in combined shorthands",
+			Message:          "get pods -Rnprod",
+			IsAuthChannel:    true,
+			ExecutorBindings: devBindings,
+			ExpectedOutput:   "Sorry, this channel doesn't have permission to run this command on cluster 'prod'. Missing permission: namespace `prod`.",
+		},
+		{
+			Name:             "Forbidden resource in comma-separated list",
+			Message:          "get pods,secrets",
+			IsAuthChannel:    true,
+			ExecutorBindings: devBindings,
+			ExpectedOutput:   "Sorry, this channel doesn't have permission to run this command on cluster 'prod'. Missing permission: resource `secrets`.",
+		},
+		{
+			Name:             "Forbidden resource in type/name arguments",
+			Message:          "get pods/app secrets/token",
+			IsAuthChannel:    true,
+			ExecutorBindings: devBindings,
+			ExpectedOutput:   "Sorry, this channel doesn't have permission to run this command on cluster 'prod'. Missing permission: resource `secrets`.",
+		},
+		{
+			Name:             "Unknown flag",
+			Message:          "get pods --namespaces prod",
+			IsAuthChannel:    true,
+			ExecutorBindings: devBindings,
+			ExpectedOutput:   "Invalid command: unknown flag: --namespaces. Please run `@BotKube help` to see command options.",
+		},
+		{
+			Name:               "Allowed resources in type/name arguments",
+			Message:            "get pods/app deployments/api -n dev-api",
+			IsAuthChannel:      true,
+			ExecutorBindings:   devBindings,
+			ExpectedOutput:     "Cluster: prod\nout",
+			ExpectedKubectlCmd: []string{"get", "pods/app", "deployments/api", "-n", "dev-api"},
+		},
+		{
+			Name:               "Verb allowed by other executor bound to the channel",
+			Message:            "rollout restart deployment/api -n prod",
+			IsAuthChannel:      true,
+			ExecutorBindings:   sreBindings,
+			ExpectedOutput:     "Cluster: prod\nout",
+			ExpectedKubectlCmd: []string{"rollout", "restart", "deployment/api", "-n", "prod"},
+		},
+		{
+			Name:               "Cluster-scoped resource",
+			Message:            "cordon node-1",
+			IsAuthChannel:      true,
+			ExecutorBindings:   sreBindings,
+			ExpectedOutput:     "Cluster: prod\nout",
+			ExpectedKubectlCmd: []string{"cordon", "node-1"},
+		},
+		{
+			Name:             "Ignored namespace",
+			Message:          "get pods -n kube-system",
+			IsAuthChannel:    true,
+			ExecutorBindings: sreBindings,
+			ExpectedOutput:   "Sorry, this channel doesn't have permission to run this command on cluster 'prod'. Missing permission: namespace `kube-system`.",
+		},
+		{
+			Name:           "Channel without executors",
+			Message:        "get pods",
+			IsAuthChannel:  true,
+			ExpectedOutput: "Sorry, no kubectl executor is bound to this channel on cluster 'prod'.",
+		},
+		{
+			Name:           "Executors with restricted access in other channel",
+			Message:        "get pods --cluster-name prod",
+			ExpectedOutput: "Sorry, no kubectl executor is bound to this channel on cluster 'prod'.",
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// given
+			var kubectlCmd []string
+			runCmdFn := func(_ context.Context, args []string) (string, error) {
+				kubectlCmd = args
+				return "out", nil
+			}
+			logger, _ := logtest.NewNullLogger()
+			factory := NewExecutorFactory(logger, runCmdFn, nil, cfg, nil, nil, resMapping, &fakeAnalyticsReporter{}, nil)
+
+			// when
+			out := factory.NewDefault(NewDefaultInput{
+				Platform:         config.SlackCommPlatformIntegration,
+				IsAuthChannel:    testCase.IsAuthChannel,
+				ExecutorBindings: testCase.ExecutorBindings,
+				Message:          testCase.Message,
+			}).Execute()
+
+			// then
+			assert.Equal(t, testCase.ExpectedOutput, out)
+			assert.Equal(t, testCase.ExpectedKubectlCmd, kubectlCmd)
+		})
+	}
+}
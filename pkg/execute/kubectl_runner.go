@@ -0,0 +1,362 @@
+package execute
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"runtime/debug"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/discovery/cached/memory"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/restmapper"
+	"k8s.io/kubectl/pkg/cmd/apiresources"
+	"k8s.io/kubectl/pkg/cmd/auth"
+	"k8s.io/kubectl/pkg/cmd/clusterinfo"
+	"k8s.io/kubectl/pkg/cmd/describe"
+	"k8s.io/kubectl/pkg/cmd/drain"
+	"k8s.io/kubectl/pkg/cmd/explain"
+	"k8s.io/kubectl/pkg/cmd/logs"
+	"k8s.io/kubectl/pkg/cmd/top"
+	cmdutil "k8s.io/kubectl/pkg/cmd/util"
+	"k8s.io/kubectl/pkg/cmd/version"
+)
+
+const kubectlCommandName = "kubectl"
+
+// newKubectlCommandFn creates a kubectl subcommand.
+type newKubectlCommandFn func(f cmdutil.Factory, streams genericclioptions.IOStreams) *cobra.Command
+
+// inProcessKubectlCommands contains the kubectl commands which can be run in-process.
+var inProcessKubectlCommands = []newKubectlCommandFn{
+	newGetCommand,
+	func(f cmdutil.Factory, streams genericclioptions.IOStreams) *cobra.Command {
+		return describe.NewCmdDescribe(kubectlCommandName, f, streams)
+	},
+	func(f cmdutil.Factory, streams genericclioptions.IOStreams) *cobra.Command {
+		return explain.NewCmdExplain(kubectlCommandName, f, streams)
+	},
+	logs.NewCmdLogs,
+	top.NewCmdTop,
+	apiresources.NewCmdAPIResources,
+	apiresources.NewCmdAPIVersions,
+	clusterinfo.NewCmdClusterInfo,
+	auth.NewCmdAuth,
+	version.NewCmdVersion,
+	newRolloutCommand,
+	drain.NewCmdCordon,
+	drain.NewCmdUncordon,
+	drain.NewCmdDrain,
+}
+
+// SupportedKubectlVerbs returns the kubectl verbs which can be run in-process, sorted alphabetically.
+func SupportedKubectlVerbs() []string {
+	root := (&KubectlRunner{}).newRootCommand(context.Background(), genericclioptions.IOStreams{})
+
+	var verbs []string
+	for _, cmd := range root.Commands() {
+		verbs = append(verbs, cmd.Name())
+	}
+	sort.Strings(verbs)
+	return verbs
+}
+
+// kubectlArgs contains the kubectl command arguments parsed in the same way as by the in-process commands.
+type kubectlArgs struct {
+	// Path contains the command names without the root command, e.g. `rollout restart`.
+	Path []string
+	// Args contains the positional arguments without the ones passed after `--`.
+	Args          []string
+	Namespace     string
+	AllNamespaces bool
+}
+
+// parseKubectlArgs parses a given kubectl command with the flags of the in-process commands,
+// so the namespace is resolved for all flag spellings, e.g. `-Rnprod` or `--all-namespaces=1`.
+func parseKubectlArgs(args []string) (kubectlArgs, error) {
+	root := (&KubectlRunner{}).newRootCommand(context.Background(), genericclioptions.IOStreams{})
+	cmd, flags, err := root.Find(args)
+	if err != nil {
+		return kubectlArgs{}, err
+	}
+	if cmd == root {
+		return kubectlArgs{}, fmt.Errorf("unknown command %q", strings.Join(args, " "))
+	}
+
+	cmd.InitDefaultHelpFlag()
+	if err := cmd.ParseFlags(flags); err != nil {
+		return kubectlArgs{}, err
+	}
+
+	out := kubectlArgs{
+		Path: strings.Fields(strings.TrimPrefix(cmd.CommandPath(), kubectlCommandName)),
+		Args: cmd.Flags().Args(),
+	}
+	if dashIdx := cmd.ArgsLenAtDash(); dashIdx >= 0 {
+		out.Args = out.Args[:dashIdx]
+	}
+	out.Namespace, err = cmd.Flags().GetString("namespace")
+	if err != nil {
+		return kubectlArgs{}, err
+	}
+	if cmd.Flags().Lookup("all-namespaces") != nil {
+		out.AllNamespaces, err = cmd.Flags().GetBool("all-namespaces")
+		if err != nil {
+			return kubectlArgs{}, err
+		}
+	}
+	return out, nil
+}
+
+// setFatalHandlerOnce guards the global kubectl fatal error handler, which may be used by the commands running in the background.
+var setFatalHandlerOnce sync.Once
+
+// kubectlFatalError is raised by the kubectl commands instead of exiting the process.
+type kubectlFatalError struct {
+	msg string
+}
+
+// outputTruncatedError is returned when the command output exceeds the maximum size.
+type outputTruncatedError struct {
+	Limit int
+}
+
+func (e *outputTruncatedError) Error() string {
+	return fmt.Sprintf("output exceeded %d bytes", e.Limit)
+}
+
+// KubectlRunner runs the kubectl commands in-process with the BotKube REST config, so neither kubectl binary nor shell is needed.
+type KubectlRunner struct {
+	log           logrus.FieldLogger
+	restConfig    *rest.Config
+	kubeconfig    string
+	maxOutputSize int
+	discovery     discovery.CachedDiscoveryInterface
+	mapper        meta.RESTMapper
+}
+
+// NewKubectlRunner returns new KubectlRunner.
+// The kubeconfig is used only to resolve the default Namespace. The in-cluster Namespace is used if it's empty.
+// Commands are stopped when their output exceeds maxOutputSize bytes. Zero means no limit.
+func NewKubectlRunner(log logrus.FieldLogger, restConfig *rest.Config, kubeconfig string, maxOutputSize int) (*KubectlRunner, error) {
+	discoveryCli, err := discovery.NewDiscoveryClientForConfig(restConfig)
+	if err != nil {
+		return nil, fmt.Errorf("while creating discovery client: %w", err)
+	}
+
+	// kubectl commands report the errors with CheckErr, which by default exits the process
+	setFatalHandlerOnce.Do(func() {
+		cmdutil.BehaviorOnFatal(func(msg string, _ int) {
+			panic(kubectlFatalError{msg: strings.TrimSpace(msg)})
+		})
+	})
+
+	cachedDiscovery := memory.NewMemCacheClient(discoveryCli)
+	return &KubectlRunner{
+		log:           log,
+		restConfig:    restConfig,
+		kubeconfig:    kubeconfig,
+		maxOutputSize: maxOutputSize,
+		discovery:     cachedDiscovery,
+		mapper:        restmapper.NewDeferredDiscoveryRESTMapper(cachedDiscovery),
+	}, nil
+}
+
+// Run runs the kubectl command with given arguments and returns its combined output.
+// The command is cancelled when the context is done. If the output exceeds the maximum size,
+// the command is cancelled and the captured output is returned together with the outputTruncatedError.
+func (r *KubectlRunner) Run(ctx context.Context, args []string) (string, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	buf := &limitedBuffer{limit: r.maxOutputSize, onLimit: cancel}
+	err := r.Stream(ctx, args, buf)
+
+	switch {
+	case buf.Truncated():
+		return buf.String(), &outputTruncatedError{Limit: r.maxOutputSize}
+	case ctx.Err() != nil:
+		// the command may still be running, e.g. waiting for a response, but its requests are already cancelled
+		return buf.String(), ctx.Err()
+	}
+	return buf.String(), err
+}
+
+// Stream runs the kubectl command and writes its combined output to a given writer as it comes.
+// It returns when the command finishes or the context is done. In the latter case, the command may still write
+// to the writer for a moment, so the writer must be safe for concurrent use, also after this method returns.
+func (r *KubectlRunner) Stream(ctx context.Context, args []string, out io.Writer) error {
+	streams := genericclioptions.IOStreams{In: &bytes.Buffer{}, Out: out, ErrOut: out}
+
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- r.execute(ctx, streams, args)
+	}()
+
+	select {
+	case err := <-errCh:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (r *KubectlRunner) execute(ctx context.Context, streams genericclioptions.IOStreams, args []string) (err error) {
+	defer func() {
+		recovered := recover()
+		if recovered == nil {
+			return
+		}
+		if fatalErr, ok := recovered.(kubectlFatalError); ok {
+			err = errors.New(fatalErr.msg)
+			return
+		}
+		// the commands run in-process, so their unexpected failures must not stop BotKube
+		r.log.Errorf("kubectl %s command panicked: %v\n\n%s", args[0], recovered, debug.Stack())
+		err = fmt.Errorf("kubectl command failed unexpectedly: %v", recovered)
+	}()
+
+	cmd := r.newRootCommand(ctx, streams)
+	cmd.SetArgs(args)
+	return cmd.ExecuteContext(ctx)
+}
+
+// newRootCommand returns the kubectl command with the subcommands which can be run in-process.
+// Commands are created for every run, as they keep the parsed flags.
+func (r *KubectlRunner) newRootCommand(ctx context.Context, streams genericclioptions.IOStreams) *cobra.Command {
+	configFlags := genericclioptions.NewConfigFlags(false)
+	configFlags.KubeConfig = &r.kubeconfig
+
+	root := &cobra.Command{
+		Use:           kubectlCommandName,
+		SilenceErrors: true,
+		SilenceUsage:  true,
+	}
+	root.CompletionOptions.DisableDefaultCmd = true
+	root.SetOut(streams.Out)
+	root.SetErr(streams.ErrOut)
+
+	// only the flags which don't change the cluster connection are exposed
+	flags := root.PersistentFlags()
+	flags.StringVarP(configFlags.Namespace, "namespace", "n", "", "If present, the namespace scope for this CLI request")
+	flags.StringVar(configFlags.Impersonate, "as", "", "Username to impersonate for the operation.")
+	flags.StringArrayVar(configFlags.ImpersonateGroup, "as-group", nil, "Group to impersonate for the operation.")
+
+	f := cmdutil.NewFactory(&restClientGetter{
+		ConfigFlags: configFlags,
+		ctx:         ctx,
+		restConfig:  r.restConfig,
+		discovery:   r.discovery,
+		mapper:      r.mapper,
+	})
+	for _, newCmd := range inProcessKubectlCommands {
+		root.AddCommand(newCmd(f, streams))
+	}
+	return root
+}
+
+// restClientGetter uses the BotKube REST config with the impersonation flags of a given command.
+// All requests are cancelled when the command context is done.
+type restClientGetter struct {
+	*genericclioptions.ConfigFlags
+	ctx        context.Context
+	restConfig *rest.Config
+	discovery  discovery.CachedDiscoveryInterface
+	mapper     meta.RESTMapper
+}
+
+// ToRESTConfig returns the BotKube REST config with the impersonated user and groups.
+func (g *restClientGetter) ToRESTConfig() (*rest.Config, error) {
+	cfg := rest.CopyConfig(g.restConfig)
+	if g.Impersonate != nil && *g.Impersonate != "" {
+		cfg.Impersonate.UserName = *g.Impersonate
+	}
+	if g.ImpersonateGroup != nil && len(*g.ImpersonateGroup) > 0 {
+		cfg.Impersonate.Groups = *g.ImpersonateGroup
+	}
+	cfg.Wrap(func(rt http.RoundTripper) http.RoundTripper {
+		return &contextRoundTripper{ctx: g.ctx, next: rt}
+	})
+	return cfg, nil
+}
+
+// ToDiscoveryClient returns the discovery client shared by all commands.
+func (g *restClientGetter) ToDiscoveryClient() (discovery.CachedDiscoveryInterface, error) {
+	return g.discovery, nil
+}
+
+// ToRESTMapper returns the REST mapper shared by all commands.
+func (g *restClientGetter) ToRESTMapper() (meta.RESTMapper, error) {
+	return g.mapper, nil
+}
+
+// contextRoundTripper cancels the requests when a given context is done.
+// Commands don't pass their context to the requests, e.g. `logs` uses context.TODO.
+type contextRoundTripper struct {
+	ctx  context.Context
+	next http.RoundTripper
+}
+
+// RoundTrip executes the request with a context which is cancelled also when the command context is done.
+// The context is not cancelled on return, as the response body may be still read.
+func (rt *contextRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	reqCtx, cancel := context.WithCancel(req.Context())
+	go func() {
+		select {
+		case <-rt.ctx.Done():
+			cancel()
+		case <-reqCtx.Done():
+		}
+	}()
+	return rt.next.RoundTrip(req.WithContext(reqCtx))
+}
+
+// limitedBuffer is a buffer safe for concurrent writes, as some commands, e.g. `logs`, write from multiple goroutines.
+// Data exceeding the limit is discarded, and onLimit is called once.
+type limitedBuffer struct {
+	mu        sync.Mutex
+	buf       bytes.Buffer
+	limit     int
+	truncated bool
+	onLimit   func()
+}
+
+func (b *limitedBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.limit <= 0 || b.buf.Len()+len(p) <= b.limit {
+		return b.buf.Write(p)
+	}
+
+	b.buf.Write(p[:b.limit-b.buf.Len()])
+	if !b.truncated {
+		b.truncated = true
+		b.onLimit()
+	}
+	// report the whole write as successful, so the command doesn't fail with a short write error
+	return len(p), nil
+}
+
+func (b *limitedBuffer) String() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.String()
+}
+
+// Truncated returns true if any data was discarded.
+func (b *limitedBuffer) Truncated() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.truncated
+}
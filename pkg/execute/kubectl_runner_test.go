@@ -0,0 +1,224 @@
+package execute
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"testing"
+	"time"
+
+	logtest "github.com/sirupsen/logrus/hooks/test"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/client-go/rest"
+	cmdutil "k8s.io/kubectl/pkg/cmd/util"
+)
+
+func TestKubectlRunner_Run(t *testing.T) {
+	testCases := []struct {
+		Name                   string
+		Args                   []string
+		MaxOutputSize          int
+		CancelContext          bool
+		ExpectedOutput         string
+		ExpectedErrorMessage   string
+		ExpectedImpersonatedAs string
+	}{
+		{
+			Name:           "Get resources",
+			Args:           []string{"-n", "default", "get", "pods", "-o", "name"},
+			ExpectedOutput: "pod/my-pod\n",
+		},
+		{
+			Name:                   "Impersonate user",
+			Args:                   []string{"--as", "jane@example.com", "get", "pods", "-n", "default", "-o", "name"},
+			ExpectedOutput:         "pod/my-pod\n",
+			ExpectedImpersonatedAs: "jane@example.com",
+		},
+		{
+			Name:                 "Not found resource",
+			Args:                 []string{"get", "pods", "other-pod", "-n", "default"},
+			ExpectedErrorMessage: `Error from server (NotFound): pods "other-pod" not found`,
+		},
+		{
+			Name:                 "Truncated output",
+			Args:                 []string{"get", "pods", "-n", "default", "-o", "name"},
+			MaxOutputSize:        5,
+			ExpectedOutput:       "pod/m",
+			ExpectedErrorMessage: "output exceeded 5 bytes",
+		},
+		{
+			Name:                 "Cancelled command",
+			Args:                 []string{"get", "pods", "-n", "default", "-o", "name"},
+			CancelContext:        true,
+			ExpectedErrorMessage: "context canceled",
+		},
+		{
+			Name:                 "Unsupported command",
+			Args:                 []string{"exec", "my-pod", "--", "sh"},
+			ExpectedErrorMessage: `unknown command "exec" for "kubectl"`,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// given
+			srv := newFakeAPIServer()
+			defer srv.Close()
+
+			logger, _ := logtest.NewNullLogger()
+			runner, err := NewKubectlRunner(logger, &rest.Config{Host: srv.URL}, "", testCase.MaxOutputSize)
+			require.NoError(t, err)
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			if testCase.CancelContext {
+				cancel()
+			}
+
+			// when
+			out, err := runner.Run(ctx, testCase.Args)
+
+			// then
+			if testCase.ExpectedErrorMessage != "" {
+				require.EqualError(t, err, testCase.ExpectedErrorMessage)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, testCase.ExpectedOutput, out)
+			assert.Equal(t, testCase.ExpectedImpersonatedAs, srv.ImpersonatedAs())
+		})
+	}
+}
+
+func TestKubectlRunner_WatchStoppedWithContext(t *testing.T) {
+	// given
+	srv := newFakeAPIServer()
+	defer srv.Close()
+
+	logger, _ := logtest.NewNullLogger()
+	runner, err := NewKubectlRunner(logger, &rest.Config{Host: srv.URL}, "", 0)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	out := &limitedBuffer{}
+	streams := genericclioptions.IOStreams{In: &bytes.Buffer{}, Out: out, ErrOut: out}
+
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- runner.execute(ctx, streams, []string{"get", "pods", "-n", "default", "-o", "name", "--watch"})
+	}()
+	require.Eventually(t, func() bool {
+		return out.String() == "pod/my-pod\n"
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// when
+	// kubectl interrupt handler would exit the process on the termination signal
+	sigCh := make(chan os.Signal, 1)
+	signal.Notify(sigCh, syscall.SIGTERM)
+	defer signal.Stop(sigCh)
+	require.NoError(t, syscall.Kill(syscall.Getpid(), syscall.SIGTERM))
+	<-sigCh
+	cancel()
+
+	// then
+	select {
+	case err := <-errCh:
+		assert.NoError(t, err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("watch should be stopped when the context is cancelled")
+	}
+}
+
+func TestKubectlRunner_RecoverFromPanic(t *testing.T) {
+	// given
+	defaultCommands := inProcessKubectlCommands
+	defer func() { inProcessKubectlCommands = defaultCommands }()
+	inProcessKubectlCommands = append(inProcessKubectlCommands, func(cmdutil.Factory, genericclioptions.IOStreams) *cobra.Command {
+		return &cobra.Command{
+			Use: "crash",
+			Run: func(*cobra.Command, []string) {
+				var pods map[string]string
+				pods["my-pod"] = "Running"
+			},
+		}
+	})
+
+	logger, hook := logtest.NewNullLogger()
+	runner, err := NewKubectlRunner(logger, &rest.Config{Host: "https://127.0.0.1:6443"}, "", 0)
+	require.NoError(t, err)
+
+	// when
+	_, err = runner.Run(context.Background(), []string{"crash"})
+
+	// then
+	require.EqualError(t, err, "kubectl command failed unexpectedly: assignment to entry in nil map")
+	require.Len(t, hook.Entries, 1)
+	assert.Contains(t, hook.LastEntry().Message, "kubectl crash command panicked")
+	assert.Contains(t, hook.LastEntry().Message, "goroutine")
+}
+
+type fakeAPIServer struct {
+	*httptest.Server
+
+	mu             sync.Mutex
+	impersonatedAs string
+}
+
+// newFakeAPIServer returns the Kubernetes API server with a single `my-pod` Pod in the `default` Namespace.
+func newFakeAPIServer() *fakeAPIServer {
+	srv := &fakeAPIServer{}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api", func(w http.ResponseWriter, _ *http.Request) {
+		writeJSON(w, http.StatusOK, `{"kind":"APIVersions","versions":["v1"]}`)
+	})
+	mux.HandleFunc("/apis", func(w http.ResponseWriter, _ *http.Request) {
+		writeJSON(w, http.StatusOK, `{"kind":"APIGroupList","apiVersion":"v1","groups":[]}`)
+	})
+	mux.HandleFunc("/api/v1", func(w http.ResponseWriter, _ *http.Request) {
+		writeJSON(w, http.StatusOK, `{"kind":"APIResourceList","groupVersion":"v1","resources":[{"name":"pods","singularName":"pod","namespaced":true,"kind":"Pod","verbs":["get","list"]}]}`)
+	})
+	mux.HandleFunc("/api/v1/namespaces/default/pods", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("watch") == "true" {
+			// the watch doesn't send any events until it's stopped by the client
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			w.(http.Flusher).Flush()
+			<-r.Context().Done()
+			return
+		}
+		srv.setImpersonatedAs(r.Header.Get("Impersonate-User"))
+		writeJSON(w, http.StatusOK, `{"kind":"PodList","apiVersion":"v1","items":[{"metadata":{"name":"my-pod","namespace":"default"}}]}`)
+	})
+	mux.HandleFunc("/api/v1/namespaces/default/pods/", func(w http.ResponseWriter, _ *http.Request) {
+		writeJSON(w, http.StatusNotFound, `{"kind":"Status","apiVersion":"v1","status":"Failure","message":"pods \"other-pod\" not found","reason":"NotFound","details":{"name":"other-pod","kind":"pods"},"code":404}`)
+	})
+	srv.Server = httptest.NewServer(mux)
+	return srv
+}
+
+func (s *fakeAPIServer) setImpersonatedAs(user string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.impersonatedAs = user
+}
+
+func (s *fakeAPIServer) ImpersonatedAs() string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.impersonatedAs
+}
+
+func writeJSON(w http.ResponseWriter, status int, body string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	fmt.Fprint(w, body)
+}
@@ -0,0 +1,282 @@
+package execute
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	metav1beta1 "k8s.io/apimachinery/pkg/apis/meta/v1beta1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/cli-runtime/pkg/printers"
+	"k8s.io/cli-runtime/pkg/resource"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/cache"
+	watchtools "k8s.io/client-go/tools/watch"
+	"k8s.io/kubectl/pkg/cmd/get"
+	"k8s.io/kubectl/pkg/cmd/rollout"
+	cmdutil "k8s.io/kubectl/pkg/cmd/util"
+	"k8s.io/kubectl/pkg/scheme"
+)
+
+// The kubectl commands below watch the resources with the command context instead of the kubectl interrupt handler.
+// The handler exits the process on SIGTERM, so the running watch would stop BotKube before its graceful shutdown.
+
+// newGetCommand returns kubectl `get` command which stops watching the resources when the command context is done.
+func newGetCommand(f cmdutil.Factory, streams genericclioptions.IOStreams) *cobra.Command {
+	o := get.NewGetOptions(kubectlCommandName, streams)
+
+	// the command is reused for its usage, but the flags are bound to the options of this function
+	cmd := get.NewCmdGet(kubectlCommandName, f, streams)
+	cmd.ResetFlags()
+	cmd.Run = func(cmd *cobra.Command, args []string) {
+		cmdutil.CheckErr(o.Complete(f, cmd, args))
+		cmdutil.CheckErr(o.Validate(cmd))
+		if len(o.Raw) == 0 && (o.Watch || o.WatchOnly) {
+			cmdutil.CheckErr(watchResources(cmd.Context(), f, o, args))
+			return
+		}
+		cmdutil.CheckErr(o.Run(f, cmd, args))
+	}
+
+	o.PrintFlags.AddFlags(cmd)
+	cmd.Flags().StringVar(&o.Raw, "raw", o.Raw, "Raw URI to request from the server.  Uses the transport specified by the kubeconfig file.")
+	cmd.Flags().BoolVarP(&o.Watch, "watch", "w", o.Watch, "After listing/getting the requested object, watch for changes.")
+	cmd.Flags().BoolVar(&o.WatchOnly, "watch-only", o.WatchOnly, "Watch for changes to the requested object(s), without listing/getting first.")
+	cmd.Flags().BoolVar(&o.OutputWatchEvents, "output-watch-events", o.OutputWatchEvents, "Output watch event objects when --watch or --watch-only is used. Existing objects are output as initial ADDED events.")
+	cmd.Flags().BoolVar(&o.IgnoreNotFound, "ignore-not-found", o.IgnoreNotFound, "If the requested object does not exist the command will return exit code 0.")
+	cmd.Flags().StringVar(&o.FieldSelector, "field-selector", o.FieldSelector, "Selector (field query) to filter on, supports '=', '==', and '!='.(e.g. --field-selector key1=value1,key2=value2). The server only supports a limited number of field queries per type.")
+	cmd.Flags().BoolVarP(&o.AllNamespaces, "all-namespaces", "A", o.AllNamespaces, "If present, list the requested object(s) across all namespaces. Namespace in current context is ignored even if specified with --namespace.")
+	cmd.Flags().BoolVar(&o.PrintWithOpenAPICols, "use-openapi-print-columns", o.PrintWithOpenAPICols, "If true, use x-kubernetes-print-column metadata (if present) from the OpenAPI schema for displaying a resource.")
+	_ = cmd.Flags().MarkDeprecated("use-openapi-print-columns", "deprecated in favor of server-side printing")
+	cmd.Flags().BoolVar(&o.ServerPrint, "server-print", o.ServerPrint, "If true, have the server return the appropriate table output. Supports extension APIs and CRDs.")
+	cmdutil.AddFilenameOptionFlags(cmd, &o.FilenameOptions, "identifying the resource to get from a server.")
+	cmdutil.AddChunkSizeFlag(cmd, &o.ChunkSize)
+	cmdutil.AddLabelSelectorFlagVar(cmd, &o.LabelSelector)
+	cmdutil.AddSubresourceFlags(cmd, &o.Subresource, "If specified, gets the subresource of the requested object.", "status", "scale")
+	return cmd
+}
+
+// watchResources prints the requested resources and their changes until the context is done.
+// It works in the same way as the kubectl `get --watch` command.
+func watchResources(ctx context.Context, f cmdutil.Factory, o *get.GetOptions, args []string) error {
+	r := f.NewBuilder().
+		Unstructured().
+		NamespaceParam(o.Namespace).DefaultNamespace().AllNamespaces(o.AllNamespaces).
+		FilenameParam(o.ExplicitNamespace, &o.FilenameOptions).
+		LabelSelectorParam(o.LabelSelector).
+		FieldSelectorParam(o.FieldSelector).
+		RequestChunksOf(o.ChunkSize).
+		ResourceTypeOrNameArgs(true, args...).
+		SingleResourceType().
+		Latest().
+		TransformRequests(func(req *rest.Request) { transformGetRequest(o, req) }).
+		Do()
+	if err := r.Err(); err != nil {
+		return err
+	}
+	infos, err := r.Infos()
+	if err != nil {
+		return err
+	}
+	if multipleGVKsRequested(infos) {
+		return errors.New("watch is only supported on individual resources and resource collections - more than 1 resource was found")
+	}
+
+	outputObjects := !o.WatchOnly
+	printer, err := o.ToPrinter(infos[0].ResourceMapping(), &outputObjects, o.AllNamespaces, false)
+	if err != nil {
+		return err
+	}
+	obj, err := r.Object()
+	if err != nil {
+		return err
+	}
+
+	// the watch of a single object starts at ~now with the initial event,
+	// and the watch of a list starts at its resource version without it
+	rv := "0"
+	isList := meta.IsListType(obj)
+	if isList {
+		rv, err = meta.NewAccessor().ResourceVersion(obj)
+		if err != nil {
+			return err
+		}
+	}
+
+	writer := printers.GetNewTabWriter(o.Out)
+	var objsToPrint []runtime.Object
+	if isList {
+		objsToPrint, _ = meta.ExtractList(obj)
+	} else {
+		objsToPrint = append(objsToPrint, obj)
+	}
+	for _, objToPrint := range objsToPrint {
+		if err := printer.PrintObj(watchEventObject(o, watch.Added, objToPrint), writer); err != nil {
+			return fmt.Errorf("unable to output the provided object: %v", err)
+		}
+	}
+	writer.Flush()
+	// the initial event of a single object is already printed
+	outputObjects = isList
+
+	w, err := r.Watch(rv)
+	if err != nil {
+		return err
+	}
+	_, err = watchtools.UntilWithoutRetry(ctx, w, func(e watch.Event) (bool, error) {
+		if err := printer.PrintObj(watchEventObject(o, e.Type, e.Object), writer); err != nil {
+			return false, err
+		}
+		writer.Flush()
+		outputObjects = true
+		return false, nil
+	})
+	if ctx.Err() != nil {
+		// the watch is stopped by the user or BotKube shutdown
+		return nil
+	}
+	return err
+}
+
+// transformGetRequest requests the server-side table for the human-readable output, in the same way as the kubectl `get` command.
+func transformGetRequest(o *get.GetOptions, req *rest.Request) {
+	if o.PrintWithOpenAPICols || !o.ServerPrint || !o.IsHumanReadablePrinter {
+		return
+	}
+
+	req.SetHeader("Accept", strings.Join([]string{
+		fmt.Sprintf("application/json;as=Table;v=%s;g=%s", metav1.SchemeGroupVersion.Version, metav1.GroupName),
+		fmt.Sprintf("application/json;as=Table;v=%s;g=%s", metav1beta1.SchemeGroupVersion.Version, metav1beta1.GroupName),
+		"application/json",
+	}, ","))
+	if o.Sort {
+		req.Param("includeObject", "Object")
+	}
+}
+
+func watchEventObject(o *get.GetOptions, eventType watch.EventType, obj runtime.Object) runtime.Object {
+	if !o.OutputWatchEvents {
+		return obj
+	}
+	return &metav1.WatchEvent{Type: string(eventType), Object: runtime.RawExtension{Object: obj}}
+}
+
+func multipleGVKsRequested(infos []*resource.Info) bool {
+	if len(infos) < 2 {
+		return false
+	}
+	gvk := infos[0].Mapping.GroupVersionKind
+	for _, info := range infos {
+		if info.Mapping.GroupVersionKind != gvk {
+			return true
+		}
+	}
+	return false
+}
+
+// newRolloutCommand returns kubectl `rollout` command with the `status` subcommand which stops when the command context is done.
+func newRolloutCommand(f cmdutil.Factory, streams genericclioptions.IOStreams) *cobra.Command {
+	cmd := rollout.NewCmdRollout(f, streams)
+	for _, sub := range cmd.Commands() {
+		if sub.Name() == "status" {
+			cmd.RemoveCommand(sub)
+		}
+	}
+	cmd.AddCommand(newRolloutStatusCommand(f, streams))
+	return cmd
+}
+
+func newRolloutStatusCommand(f cmdutil.Factory, streams genericclioptions.IOStreams) *cobra.Command {
+	o := rollout.NewRolloutStatusOptions(streams)
+
+	// the command is reused for its usage, but the flags are bound to the options of this function
+	cmd := rollout.NewCmdRolloutStatus(f, streams)
+	cmd.ResetFlags()
+	cmd.Run = func(cmd *cobra.Command, args []string) {
+		cmdutil.CheckErr(o.Complete(f, args))
+		cmdutil.CheckErr(o.Validate())
+		cmdutil.CheckErr(watchRolloutStatus(cmd.Context(), o))
+	}
+
+	cmdutil.AddFilenameOptionFlags(cmd, o.FilenameOptions, "identifying the resource to get from a server.")
+	cmd.Flags().BoolVarP(&o.Watch, "watch", "w", o.Watch, "Watch the status of the rollout until it's done.")
+	cmd.Flags().Int64Var(&o.Revision, "revision", o.Revision, "Pin to a specific revision for showing its status. Defaults to 0 (last revision).")
+	cmd.Flags().DurationVar(&o.Timeout, "timeout", o.Timeout, "The length of time to wait before ending watch, zero means never. Any other values should contain a corresponding time unit (e.g. 1s, 2m, 3h).")
+	cmdutil.AddLabelSelectorFlagVar(cmd, &o.LabelSelector)
+	return cmd
+}
+
+// watchRolloutStatus prints the rollout status until the rollout is done or the context is done.
+// It works in the same way as the kubectl `rollout status` command.
+func watchRolloutStatus(ctx context.Context, o *rollout.RolloutStatusOptions) error {
+	r := o.Builder().
+		WithScheme(scheme.Scheme, scheme.Scheme.PrioritizedVersionsAllGroups()...).
+		NamespaceParam(o.Namespace).DefaultNamespace().
+		LabelSelectorParam(o.LabelSelector).
+		FilenameParam(o.EnforceNamespace, o.FilenameOptions).
+		ResourceTypeOrNameArgs(true, o.BuilderArgs...).
+		SingleResourceType().
+		Latest().
+		Do()
+	if err := r.Err(); err != nil {
+		return err
+	}
+	infos, err := r.Infos()
+	if err != nil {
+		return err
+	}
+	if len(infos) != 1 {
+		return fmt.Errorf("rollout status is only supported on individual resources and resource collections - %d resources were found", len(infos))
+	}
+	info := infos[0]
+
+	statusViewer, err := o.StatusViewerFn(info.ResourceMapping())
+	if err != nil {
+		return err
+	}
+
+	fieldSelector := fields.OneTermEqualSelector("metadata.name", info.Name).String()
+	client := o.DynamicClient.Resource(info.Mapping.Resource).Namespace(info.Namespace)
+	lw := &cache.ListWatch{
+		ListFunc: func(options metav1.ListOptions) (runtime.Object, error) {
+			options.FieldSelector = fieldSelector
+			return client.List(ctx, options)
+		},
+		WatchFunc: func(options metav1.ListOptions) (watch.Interface, error) {
+			options.FieldSelector = fieldSelector
+			return client.Watch(ctx, options)
+		},
+	}
+
+	watchCtx, cancel := watchtools.ContextWithOptionalTimeout(ctx, o.Timeout)
+	defer cancel()
+	_, err = watchtools.UntilWithSync(watchCtx, lw, &unstructured.Unstructured{}, nil, func(e watch.Event) (bool, error) {
+		switch e.Type {
+		case watch.Added, watch.Modified:
+			status, done, err := statusViewer.Status(e.Object.(runtime.Unstructured), o.Revision)
+			if err != nil {
+				return false, err
+			}
+			fmt.Fprintf(o.Out, "%s", status)
+			return done || !o.Watch, nil
+		case watch.Deleted:
+			// the watch is stopped, so the recreated object is not watched silently
+			return true, errors.New("object has been deleted")
+		default:
+			return true, fmt.Errorf("internal error: unexpected event %#v", e)
+		}
+	})
+	if ctx.Err() != nil {
+		// the watch is stopped by the user or BotKube shutdown
+		return nil
+	}
+	return err
+}
@@ -2,6 +2,7 @@ package execute
 
 import (
 	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/sirupsen/logrus"
@@ -11,33 +12,44 @@ import (
 )
 
 // ResourceMapping contains helper maps for kubectl execution.
+// Allowed verbs and resources are merged from all enabled kubectl executors.
 type ResourceMapping struct {
 	KindResourceMap           map[string]string
 	ShortnameResourceMap      map[string]string
+	ClusterScopedResourceMap  map[string]bool
 	AllowedKubectlResourceMap map[string]bool
 	AllowedKubectlVerbMap     map[string]bool
 }
 
 // LoadResourceMappingIfShould initializes helper maps to allow kubectl execution for required resources.
 // If Kubectl support is disabled, it returns empty ResourceMapping without an error.
+// The verbs which cannot be run in-process are removed from the kubectl executors with a warning.
 func LoadResourceMappingIfShould(log logrus.FieldLogger, conf *config.Config, discoveryCli discovery.DiscoveryInterface) (ResourceMapping, error) {
-	if !conf.Executors.GetFirst().Kubectl.Enabled {
+	if !isKubectlEnabled(conf.Executors) {
 		log.Infof("Kubectl disabled. Finishing...")
 		return ResourceMapping{}, nil
 	}
 
+	dropUnsupportedKubectlVerbs(log, conf.Executors)
+
 	resMapping := ResourceMapping{
 		KindResourceMap:           make(map[string]string),
 		ShortnameResourceMap:      make(map[string]string),
+		ClusterScopedResourceMap:  make(map[string]bool),
 		AllowedKubectlResourceMap: make(map[string]bool),
 		AllowedKubectlVerbMap:     make(map[string]bool),
 	}
 
-	for _, r := range conf.Executors.GetFirst().Kubectl.Commands.Resources {
-		resMapping.AllowedKubectlResourceMap[r] = true
-	}
-	for _, r := range conf.Executors.GetFirst().Kubectl.Commands.Verbs {
-		resMapping.AllowedKubectlVerbMap[r] = true
+	for _, executor := range conf.Executors {
+		if !executor.Kubectl.Enabled {
+			continue
+		}
+		for _, r := range executor.Kubectl.Commands.Resources {
+			resMapping.AllowedKubectlResourceMap[r] = true
+		}
+		for _, r := range executor.Kubectl.Commands.Verbs {
+			resMapping.AllowedKubectlVerbMap[r] = true
+		}
 	}
 
 	_, resourceList, err := discoveryCli.ServerGroupsAndResources()
@@ -54,8 +66,58 @@ func LoadResourceMappingIfShould(log logrus.FieldLogger, conf *config.Config, di
 			for _, sn := range r.ShortNames {
 				resMapping.ShortnameResourceMap[sn] = r.Name
 			}
+			if !r.Namespaced {
+				resMapping.ClusterScopedResourceMap[r.Name] = true
+			}
 		}
 	}
 	log.Infof("Loaded resource mapping: %+v", resMapping)
 	return resMapping, nil
 }
+
+// dropUnsupportedKubectlVerbs removes the verbs which cannot be run in-process from the enabled kubectl executors.
+// The previous versions ran the kubectl binary, so such verbs may be still configured.
+func dropUnsupportedKubectlVerbs(log logrus.FieldLogger, executors config.IndexableMap[config.Executors]) {
+	supported := map[string]bool{}
+	for _, verb := range SupportedKubectlVerbs() {
+		supported[verb] = true
+	}
+
+	var names []string
+	for name := range executors {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		executor := executors[name]
+		if !executor.Kubectl.Enabled {
+			continue
+		}
+		var verbs, unsupported []string
+		for _, verb := range executor.Kubectl.Commands.Verbs {
+			if !supported[verb] {
+				unsupported = append(unsupported, fmt.Sprintf("%q", verb))
+				continue
+			}
+			verbs = append(verbs, verb)
+		}
+		if len(unsupported) == 0 {
+			continue
+		}
+		log.Warnf("Ignoring kubectl verbs %s of the %q executor, as they are not supported. Supported verbs: %s",
+			strings.Join(unsupported, ", "), name, strings.Join(SupportedKubectlVerbs(), ", "))
+		executor.Kubectl.Commands.Verbs = verbs
+		executors[name] = executor
+	}
+}
+
+// isKubectlEnabled returns true if any of the kubectl executors is enabled.
+func isKubectlEnabled(executors config.IndexableMap[config.Executors]) bool {
+	for _, executor := range executors {
+		if executor.Kubectl.Enabled {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,48 @@
+package execute
+
+import (
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	logtest "github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kubeshop/botkube/pkg/config"
+)
+
+func TestDropUnsupportedKubectlVerbs(t *testing.T) {
+	// given
+	logger, hook := logtest.NewNullLogger()
+	executors := config.IndexableMap[config.Executors]{
+		"kubectl-read-only": {Kubectl: config.Kubectl{
+			Enabled: true,
+			Commands: config.Commands{
+				Verbs: []string{"get", "logs"},
+			},
+		}},
+		"kubectl-admin": {Kubectl: config.Kubectl{
+			Enabled: true,
+			Commands: config.Commands{
+				Verbs: []string{"get", "delete", "exec"},
+			},
+		}},
+		"kubectl-disabled": {Kubectl: config.Kubectl{
+			Commands: config.Commands{
+				Verbs: []string{"apply"},
+			},
+		}},
+	}
+
+	// when
+	dropUnsupportedKubectlVerbs(logger, executors)
+
+	// then
+	assert.Equal(t, []string{"get", "logs"}, executors["kubectl-read-only"].Kubectl.Commands.Verbs)
+	assert.Equal(t, []string{"get"}, executors["kubectl-admin"].Kubectl.Commands.Verbs)
+	assert.Equal(t, []string{"apply"}, executors["kubectl-disabled"].Kubectl.Commands.Verbs)
+
+	require.Len(t, hook.Entries, 1)
+	assert.Equal(t, logrus.WarnLevel, hook.LastEntry().Level)
+	assert.Equal(t, `Ignoring kubectl verbs "delete", "exec" of the "kubectl-admin" executor, as they are not supported. Supported verbs: api-resources, api-versions, auth, cluster-info, cordon, describe, drain, explain, get, logs, rollout, top, uncordon, version`, hook.LastEntry().Message)
+}
@@ -0,0 +1,204 @@
+package execute
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/kubeshop/botkube/pkg/audit"
+)
+
+const (
+	streamStartedMsg     = "Streaming session '%s' for `%s` has started.%s Run `stop %s` to end it."
+	streamLimitsMsg      = " It ends after %s."
+	streamFinishedMsg    = "Streaming session '%s' has finished."
+	streamStoppedMsg     = "Streaming session '%s' has been stopped."
+	streamTimeoutMsg     = "Streaming session '%s' has ended after %s."
+	streamMaxLinesMsg    = "Streaming session '%s' has ended after %d lines."
+	streamFailedMsg      = "Streaming session '%s' has failed: %s"
+	noStreamsMsg         = "There are no streaming sessions on cluster '%s'."
+	streamNotFoundMsg    = "There is no streaming session with ID '%s' on cluster '%s'."
+	streamStopSuccessMsg = "Done. Streaming session '%s' (`%s`) on cluster '%s' has been stopped."
+)
+
+// OutputStreamer posts the output of the streaming sessions, e.g. `logs -f`, to the chat.
+type OutputStreamer interface {
+	// StreamOutput posts a given part of the output, e.g. as a thread reply or by updating the previous message.
+	StreamOutput(out string) error
+}
+
+// canStreamOutput returns true if the output of the `--follow` and `--watch` commands can be streamed on the current platform.
+func (e *DefaultExecutor) canStreamOutput() bool {
+	return e.outputStreamer != nil && e.streamCmdFn != nil
+}
+
+// startStreamingSession runs the kubectl command in the background and posts its output in batches,
+// until the command finishes, the session is stopped, or one of the configured limits is reached.
+func (e *DefaultExecutor) startStreamingSession(command string, args []string) string {
+	limits := e.cfg.Settings.Execution.Streaming
+	// the record of the started session is sent concurrently, so the final result is recorded on a copy
+	auditEvent, start := e.auditEvent, time.Now()
+	id, jobCtx, finish, err := e.jobs.start(command, e.Channel, limits.MaxDuration, true)
+	if err != nil {
+		e.log.Errorf("while starting streaming session: %s", err.Error())
+		e.setAuditResult(audit.StatusFailed, err.Error())
+		return err.Error()
+	}
+	ctx, cancel := context.WithCancel(jobCtx)
+	buf := &lineBuffer{maxLines: limits.MaxLines, onLimit: cancel}
+
+	go func() {
+		defer finish()
+		defer cancel()
+
+		err := e.streamOutput(ctx, args, buf, limits.UpdateInterval)
+
+		var endMsg string
+		status, reason := audit.StatusSucceeded, ""
+		switch {
+		case buf.LimitReached():
+			endMsg = fmt.Sprintf(streamMaxLinesMsg, id, limits.MaxLines)
+		case errors.Is(jobCtx.Err(), context.DeadlineExceeded):
+			endMsg = fmt.Sprintf(streamTimeoutMsg, id, limits.MaxDuration)
+		case errors.Is(jobCtx.Err(), context.Canceled):
+			endMsg = fmt.Sprintf(streamStoppedMsg, id)
+		case err != nil:
+			endMsg = fmt.Sprintf(streamFailedMsg, id, err.Error())
+			status, reason = audit.StatusFailed, err.Error()
+		default:
+			endMsg = fmt.Sprintf(streamFinishedMsg, id)
+		}
+		e.log.Infof("Streaming session %s for %q ended", id, command)
+		e.postStreamOutput(buf.flush(true))
+		e.postStreamOutput(endMsg)
+		e.auditStreamingSessionEnd(auditEvent, start, status, reason)
+	}()
+
+	var limitsDesc []string
+	if limits.MaxDuration > 0 {
+		limitsDesc = append(limitsDesc, limits.MaxDuration.String())
+	}
+	if limits.MaxLines > 0 {
+		limitsDesc = append(limitsDesc, fmt.Sprintf("%d lines", limits.MaxLines))
+	}
+	var limitsMsg string
+	if len(limitsDesc) > 0 {
+		limitsMsg = fmt.Sprintf(streamLimitsMsg, strings.Join(limitsDesc, " or "))
+	}
+	return fmt.Sprintf(streamStartedMsg, id, command, limitsMsg, id)
+}
+
+// streamOutput runs the command and posts its new output in the given interval. It returns when the command finishes.
+func (e *DefaultExecutor) streamOutput(ctx context.Context, args []string, buf *lineBuffer, interval time.Duration) error {
+	done := make(chan error, 1)
+	go func() {
+		done <- e.streamCmdFn(ctx, args, buf)
+	}()
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			e.postStreamOutput(buf.flush(false))
+		case err := <-done:
+			return err
+		}
+	}
+}
+
+func (e *DefaultExecutor) postStreamOutput(out string) {
+	if strings.TrimSpace(out) == "" {
+		return
+	}
+	if err := e.outputStreamer.StreamOutput(out); err != nil {
+		e.log.Errorf("while posting streamed output: %s", err.Error())
+	}
+}
+
+// runStopCommand stops a given streaming session, or lists the running sessions.
+func (e *DefaultExecutor) runStopCommand(cmd parsedCommand) string {
+	clusterName := e.cfg.Settings.ClusterName
+	if len(cmd.Args) == 0 {
+		var sessions []job
+		for _, j := range e.jobs.list(e.Channel) {
+			if j.Streaming {
+				sessions = append(sessions, j)
+			}
+		}
+		if len(sessions) == 0 {
+			return fmt.Sprintf(noStreamsMsg, clusterName)
+		}
+		return fmt.Sprintf("Streaming sessions on cluster '%s':\n%s", clusterName, jobsTable(sessions))
+	}
+
+	id := cmd.Args[0]
+	j, found := e.jobs.cancel(id, e.Channel, true)
+	if !found {
+		return fmt.Sprintf(streamNotFoundMsg, id, clusterName)
+	}
+	return fmt.Sprintf(streamStopSuccessMsg, j.ID, j.Command, clusterName)
+}
+
+// lineBuffer collects the streamed output until it's posted. It's safe for concurrent use.
+// When the number of lines reaches the limit, the rest of the output is discarded and onLimit is called.
+type lineBuffer struct {
+	mu           sync.Mutex
+	pending      bytes.Buffer
+	lines        int
+	maxLines     int
+	limitReached bool
+	onLimit      func()
+}
+
+func (b *lineBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	n := len(p)
+	for len(p) > 0 && !b.limitReached {
+		idx := bytes.IndexByte(p, '\n')
+		if idx < 0 {
+			b.pending.Write(p)
+			break
+		}
+
+		b.pending.Write(p[:idx+1])
+		p = p[idx+1:]
+		b.lines++
+		if b.maxLines > 0 && b.lines >= b.maxLines {
+			b.limitReached = true
+			b.onLimit()
+		}
+	}
+	return n, nil
+}
+
+// flush returns the output collected since the last call. The last incomplete line is returned only if partial is true.
+func (b *lineBuffer) flush(partial bool) string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	data := b.pending.Bytes()
+	end := len(data)
+	if !partial {
+		end = bytes.LastIndexByte(data, '\n') + 1
+	}
+	out := string(data[:end])
+	rest := append([]byte{}, data[end:]...)
+
+	b.pending.Reset()
+	b.pending.Write(rest)
+	return out
+}
+
+// LimitReached returns true if the maximum number of lines was reached.
+func (b *lineBuffer) LimitReached() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.limitReached
+}
@@ -0,0 +1,215 @@
+package execute
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+	"testing"
+	"time"
+
+	logtest "github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kubeshop/botkube/pkg/audit"
+	"github.com/kubeshop/botkube/pkg/config"
+)
+
+func TestDefaultExecutor_StreamingSession(t *testing.T) {
+	resMapping := ResourceMapping{
+		AllowedKubectlResourceMap: map[string]bool{"pods": true},
+		AllowedKubectlVerbMap:     map[string]bool{"logs": true},
+	}
+
+	testCases := []struct {
+		Name                string
+		Limits              config.StreamingSessions
+		StreamCmdFn         CommandStreamerFunc
+		ExpectedOutput      string
+		ExpectedStreams     []string
+		ExpectedAuditStatus audit.Status
+		ExpectedAuditError  string
+	}{
+		{
+			Name:   "Command finished",
+			Limits: config.StreamingSessions{MaxDuration: time.Minute, MaxLines: 10},
+			StreamCmdFn: func(_ context.Context, _ []string, out io.Writer) error {
+				fmt.Fprint(out, "line 1\nline 2")
+				return nil
+			},
+			ExpectedOutput:      "Cluster: prod\nStreaming session 'a1b2c3' for `logs my-pod -f` has started. It ends after 1m0s or 10 lines. Run `stop a1b2c3` to end it.",
+			ExpectedStreams:     []string{"line 1\nline 2", "Streaming session 'a1b2c3' has finished."},
+			ExpectedAuditStatus: audit.StatusSucceeded,
+		},
+		{
+			Name:   "Max lines reached",
+			Limits: config.StreamingSessions{MaxLines: 2},
+			StreamCmdFn: func(ctx context.Context, _ []string, out io.Writer) error {
+				fmt.Fprint(out, "line 1\nline 2\nline 3\n")
+				<-ctx.Done()
+				return ctx.Err()
+			},
+			ExpectedOutput:      "Cluster: prod\nStreaming session 'a1b2c3' for `logs my-pod -f` has started. It ends after 2 lines. Run `stop a1b2c3` to end it.",
+			ExpectedStreams:     []string{"line 1\nline 2\n", "Streaming session 'a1b2c3' has ended after 2 lines."},
+			ExpectedAuditStatus: audit.StatusSucceeded,
+		},
+		{
+			Name:   "Max duration reached",
+			Limits: config.StreamingSessions{MaxDuration: 10 * time.Millisecond},
+			StreamCmdFn: func(ctx context.Context, _ []string, out io.Writer) error {
+				fmt.Fprint(out, "line 1\n")
+				<-ctx.Done()
+				return ctx.Err()
+			},
+			ExpectedOutput:      "Cluster: prod\nStreaming session 'a1b2c3' for `logs my-pod -f` has started. It ends after 10ms. Run `stop a1b2c3` to end it.",
+			ExpectedStreams:     []string{"line 1\n", "Streaming session 'a1b2c3' has ended after 10ms."},
+			ExpectedAuditStatus: audit.StatusSucceeded,
+		},
+		{
+			Name: "Command failed",
+			StreamCmdFn: func(_ context.Context, _ []string, _ io.Writer) error {
+				return errors.New("pod not found")
+			},
+			ExpectedOutput:      "Cluster: prod\nStreaming session 'a1b2c3' for `logs my-pod -f` has started. Run `stop a1b2c3` to end it.",
+			ExpectedStreams:     []string{"Streaming session 'a1b2c3' has failed: pod not found"},
+			ExpectedAuditStatus: audit.StatusFailed,
+			ExpectedAuditError:  "pod not found",
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// given
+			cfg := newJobsTestConfig(0)
+			testCase.Limits.UpdateInterval = time.Hour
+			cfg.Settings.Execution.Streaming = testCase.Limits
+
+			var kubectlCmd []string
+			streamCmdFn := func(ctx context.Context, args []string, out io.Writer) error {
+				kubectlCmd = args
+				return testCase.StreamCmdFn(ctx, args, out)
+			}
+			streamer := &fakeOutputStreamer{}
+			auditor := &fakeAuditor{}
+			logger, _ := logtest.NewNullLogger()
+			factory := NewExecutorFactory(logger, nil, streamCmdFn, cfg, nil, nil, resMapping, &fakeAnalyticsReporter{}, auditor)
+			factory.jobs.idFn = func() (string, error) { return "a1b2c3", nil }
+
+			// when
+			out := newStreamingTestExecutor(factory, streamer, "logs my-pod -f").Execute()
+
+			// then
+			assert.Equal(t, testCase.ExpectedOutput, out)
+			require.Eventually(t, func() bool {
+				return len(streamer.Outputs()) == len(testCase.ExpectedStreams)
+			}, time.Second, 5*time.Millisecond)
+			assert.Equal(t, testCase.ExpectedStreams, streamer.Outputs())
+			assert.Equal(t, []string{"logs", "my-pod", "-f"}, kubectlCmd)
+
+			require.Eventually(t, func() bool {
+				return len(auditor.Events()) == 2
+			}, time.Second, 5*time.Millisecond)
+			events := auditor.Events()
+			assert.Equal(t, audit.StatusStarted, events[0].Status)
+			assert.Equal(t, "logs my-pod -f", events[1].Command)
+			assert.Equal(t, testCase.ExpectedAuditStatus, events[1].Status)
+			assert.Equal(t, testCase.ExpectedAuditError, events[1].Error)
+		})
+	}
+}
+
+func TestDefaultExecutor_StreamingNotSupported(t *testing.T) {
+	// given
+	resMapping := ResourceMapping{
+		AllowedKubectlResourceMap: map[string]bool{"pods": true},
+		AllowedKubectlVerbMap:     map[string]bool{"logs": true},
+	}
+	var kubectlCmd []string
+	runCmdFn := func(_ context.Context, args []string) (string, error) {
+		kubectlCmd = args
+		return "line 1", nil
+	}
+	logger, _ := logtest.NewNullLogger()
+	factory := NewExecutorFactory(logger, runCmdFn, nil, newJobsTestConfig(0), nil, nil, resMapping, &fakeAnalyticsReporter{}, nil)
+
+	// when
+	out := newStreamingTestExecutor(factory, nil, "logs my-pod --follow").Execute()
+
+	// then
+	assert.Equal(t, "Cluster: prod\nline 1", out)
+	assert.Equal(t, []string{"logs", "my-pod"}, kubectlCmd)
+}
+
+func TestDefaultExecutor_StopCommand(t *testing.T) {
+	// given
+	resMapping := ResourceMapping{
+		AllowedKubectlResourceMap: map[string]bool{"pods": true},
+		AllowedKubectlVerbMap:     map[string]bool{"logs": true},
+	}
+	streamCmdFn := func(ctx context.Context, _ []string, _ io.Writer) error {
+		<-ctx.Done()
+		return ctx.Err()
+	}
+	cfg := newJobsTestConfig(0)
+	cfg.Settings.Execution.Streaming.UpdateInterval = time.Hour
+	streamer := &fakeOutputStreamer{}
+	logger, _ := logtest.NewNullLogger()
+	factory := NewExecutorFactory(logger, nil, streamCmdFn, cfg, nil, nil, resMapping, &fakeAnalyticsReporter{}, nil)
+	factory.jobs.idFn = func() (string, error) { return "a1b2c3", nil }
+
+	started := newStreamingTestExecutor(factory, streamer, "logs my-pod -f").Execute()
+	require.Equal(t, "Cluster: prod\nStreaming session 'a1b2c3' for `logs my-pod -f` has started. Run `stop a1b2c3` to end it.", started)
+
+	// when
+	list := newStreamingTestExecutor(factory, streamer, "stop").Execute()
+	notFound := newStreamingTestExecutor(factory, streamer, "stop d4e5f6").Execute()
+	otherChannelList := newStreamingTestExecutorInChannel(factory, streamer, "C002", "stop").Execute()
+	otherChannelStop := newStreamingTestExecutorInChannel(factory, streamer, "C002", "stop a1b2c3").Execute()
+	stopped := newStreamingTestExecutor(factory, streamer, "stop a1b2c3").Execute()
+
+	// then
+	assert.Equal(t, "Streaming sessions on cluster 'prod':\nID     COMMAND        RUNNING FOR\na1b2c3 logs my-pod -f 0s\n", list)
+	assert.Equal(t, "There is no streaming session with ID 'd4e5f6' on cluster 'prod'.", notFound)
+	assert.Equal(t, "There are no streaming sessions on cluster 'prod'.", otherChannelList, "sessions should be listed only in the channel which started them")
+	assert.Equal(t, "There is no streaming session with ID 'a1b2c3' on cluster 'prod'.", otherChannelStop, "sessions should be stopped only from the channel which started them")
+	assert.Equal(t, "Done. Streaming session 'a1b2c3' (`logs my-pod -f`) on cluster 'prod' has been stopped.", stopped)
+	require.Eventually(t, func() bool {
+		return len(streamer.Outputs()) == 1
+	}, time.Second, 5*time.Millisecond)
+	assert.Equal(t, []string{"Streaming session 'a1b2c3' has been stopped."}, streamer.Outputs())
+	assert.Equal(t, "There are no streaming sessions on cluster 'prod'.", newStreamingTestExecutor(factory, streamer, "stop").Execute())
+}
+
+func newStreamingTestExecutor(factory *DefaultExecutorFactory, streamer OutputStreamer, msg string) Executor {
+	return newStreamingTestExecutorInChannel(factory, streamer, "C001", msg)
+}
+
+func newStreamingTestExecutorInChannel(factory *DefaultExecutorFactory, streamer OutputStreamer, channel, msg string) Executor {
+	return factory.NewDefault(NewDefaultInput{
+		Platform:         config.SlackCommPlatformIntegration,
+		IsAuthChannel:    true,
+		ExecutorBindings: []string{"kubectl"},
+		Message:          msg,
+		OutputStreamer:   streamer,
+		Channel:          channel,
+	})
+}
+
+type fakeOutputStreamer struct {
+	mu      sync.Mutex
+	outputs []string
+}
+
+func (f *fakeOutputStreamer) StreamOutput(out string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.outputs = append(f.outputs, out)
+	return nil
+}
+
+func (f *fakeOutputStreamer) Outputs() []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]string{}, f.outputs...)
+}
@@ -0,0 +1,227 @@
+package filters
+
+import (
+	"context"
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"gopkg.in/yaml.v3"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/version"
+	"k8s.io/client-go/discovery"
+
+	"github.com/kubeshop/botkube/pkg/config"
+	"github.com/kubeshop/botkube/pkg/events"
+	"github.com/kubeshop/botkube/pkg/utils"
+)
+
+const (
+	lastAppliedConfigAnnotation = "kubectl.kubernetes.io/last-applied-configuration"
+
+	serverVersionInitialBackoff = 30 * time.Second
+	serverVersionMaxBackoff     = 10 * time.Minute
+)
+
+//go:embed deprecated_apis.yaml
+var deprecatedAPIsRaw []byte
+
+// deprecatedAPI describes API version deprecated for given kinds.
+type deprecatedAPI struct {
+	APIVersion   string   `yaml:"apiVersion"`
+	Kinds        []string `yaml:"kinds"`
+	DeprecatedIn string   `yaml:"deprecatedIn"`
+	RemovedIn    string   `yaml:"removedIn"`
+	Replacement  string   `yaml:"replacement"`
+}
+
+// DeprecatedAPIChecker adds recommendations to the event object if the object is created or updated
+// with the API version which is deprecated or removed in the current or upcoming Kubernetes releases.
+type DeprecatedAPIChecker struct {
+	log          logrus.FieldLogger
+	discoveryCli discovery.ServerVersionInterface
+	apis         []deprecatedAPI
+	nowFn        func() time.Time
+
+	mu            sync.Mutex
+	serverVersion *version.Version
+	// retryAfter and backoff prevent querying the server version on every event when it cannot be fetched.
+	retryAfter time.Time
+	backoff    time.Duration
+}
+
+// NewDeprecatedAPIChecker creates a new DeprecatedAPIChecker instance
+func NewDeprecatedAPIChecker(log logrus.FieldLogger, discoveryCli discovery.ServerVersionInterface) *DeprecatedAPIChecker {
+	apis, err := loadDeprecatedAPIs()
+	if err != nil {
+		log.Errorf("while loading deprecated APIs: %s", err.Error())
+	}
+
+	return &DeprecatedAPIChecker{log: log, discoveryCli: discoveryCli, apis: apis, nowFn: time.Now}
+}
+
+// Run filers and modifies event struct
+func (f *DeprecatedAPIChecker) Run(_ context.Context, object interface{}, event *events.Event) error {
+	if event.Type != config.CreateEvent && event.Type != config.UpdateEvent {
+		return nil
+	}
+	if utils.GetObjectTypeMetaData(object).Kind == "Event" {
+		return nil
+	}
+
+	unstrObj, ok := object.(*unstructured.Unstructured)
+	if !ok {
+		return fmt.Errorf("cannot convert type %T into *unstructured.Unstructured", object)
+	}
+
+	serverVersion := f.getServerVersion()
+	for _, apiVersion := range usedAPIVersions(unstrObj, event.APIVersion) {
+		api, found := f.findDeprecatedAPI(apiVersion, event.Kind)
+		if !found {
+			continue
+		}
+
+		msg, ok := api.recommendation(serverVersion, event.Kind, event.Name)
+		if !ok {
+			continue
+		}
+		event.Recommendations = append(event.Recommendations, msg)
+	}
+
+	f.log.Debug("Deprecated API filter successful!")
+	return nil
+}
+
+// Name returns the filter's name
+func (f *DeprecatedAPIChecker) Name() string {
+	return "DeprecatedAPIChecker"
+}
+
+// Describe describes the filter
+func (f *DeprecatedAPIChecker) Describe() string {
+	return "Checks and adds recommendation if deprecated or removed API version is used for the object."
+}
+
+func (f *DeprecatedAPIChecker) findDeprecatedAPI(apiVersion, kind string) (deprecatedAPI, bool) {
+	for _, api := range f.apis {
+		if api.APIVersion != apiVersion {
+			continue
+		}
+		for _, k := range api.Kinds {
+			if k == kind {
+				return api, true
+			}
+		}
+	}
+	return deprecatedAPI{}, false
+}
+
+// getServerVersion returns the Kubernetes server version.
+// The version is fetched once, and nil is returned if it cannot be fetched.
+// After a failure, the version is fetched again with an exponential backoff.
+func (f *DeprecatedAPIChecker) getServerVersion() *version.Version {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.serverVersion != nil {
+		return f.serverVersion
+	}
+	now := f.nowFn()
+	if now.Before(f.retryAfter) {
+		return nil
+	}
+
+	ver, err := f.fetchServerVersion()
+	if err != nil {
+		f.backoff = nextServerVersionBackoff(f.backoff)
+		f.retryAfter = now.Add(f.backoff)
+		f.log.Errorf("%s. Retrying in %s...", err.Error(), f.backoff)
+		return nil
+	}
+
+	f.serverVersion = ver
+	return f.serverVersion
+}
+
+func (f *DeprecatedAPIChecker) fetchServerVersion() (*version.Version, error) {
+	info, err := f.discoveryCli.ServerVersion()
+	if err != nil {
+		return nil, fmt.Errorf("while getting Kubernetes server version: %w", err)
+	}
+	ver, err := version.ParseGeneric(info.GitVersion)
+	if err != nil {
+		return nil, fmt.Errorf("while parsing Kubernetes server version %q: %w", info.GitVersion, err)
+	}
+	return ver, nil
+}
+
+func nextServerVersionBackoff(current time.Duration) time.Duration {
+	if current == 0 {
+		return serverVersionInitialBackoff
+	}
+	if next := 2 * current; next < serverVersionMaxBackoff {
+		return next
+	}
+	return serverVersionMaxBackoff
+}
+
+func loadDeprecatedAPIs() ([]deprecatedAPI, error) {
+	var apis []deprecatedAPI
+	if err := yaml.Unmarshal(deprecatedAPIsRaw, &apis); err != nil {
+		return nil, err
+	}
+	return apis, nil
+}
+
+// recommendation returns the recommendation message for the given server version.
+// If the server version is unknown, the API is reported as deprecated.
+func (a deprecatedAPI) recommendation(serverVersion *version.Version, kind, name string) (string, bool) {
+	deprecatedIn, err := version.ParseGeneric(a.DeprecatedIn)
+	if err != nil {
+		return "", false
+	}
+	removedIn, err := version.ParseGeneric(a.RemovedIn)
+	if err != nil {
+		return "", false
+	}
+
+	replacement := "There is no replacement for this API."
+	if a.Replacement != "" {
+		replacement = fmt.Sprintf("Use '%s' instead.", a.Replacement)
+	}
+
+	switch {
+	case serverVersion == nil:
+	case serverVersion.AtLeast(removedIn):
+		return fmt.Sprintf("%s '%s' uses API version '%s' which was removed in Kubernetes v%s. %s", kind, name, a.APIVersion, a.RemovedIn, replacement), true
+	case !serverVersion.AtLeast(deprecatedIn):
+		return "", false
+	}
+
+	return fmt.Sprintf("%s '%s' uses API version '%s' which is deprecated and will be removed in Kubernetes v%s. %s", kind, name, a.APIVersion, a.RemovedIn, replacement), true
+}
+
+// usedAPIVersions returns the API version of the watched object, and the API version
+// from the last applied configuration, as the object could be applied with a different version than the watched one.
+func usedAPIVersions(obj *unstructured.Unstructured, watchedAPIVersion string) []string {
+	out := []string{watchedAPIVersion}
+
+	lastApplied, ok := obj.GetAnnotations()[lastAppliedConfigAnnotation]
+	if !ok {
+		return out
+	}
+
+	var meta struct {
+		APIVersion string `json:"apiVersion"`
+	}
+	if err := json.Unmarshal([]byte(lastApplied), &meta); err != nil {
+		return out
+	}
+	if meta.APIVersion != "" && meta.APIVersion != watchedAPIVersion {
+		out = append(out, meta.APIVersion)
+	}
+	return out
+}
@@ -0,0 +1,172 @@
+package filters
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	logtest "github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/version"
+	apiversion "k8s.io/apimachinery/pkg/version"
+	fakediscovery "k8s.io/client-go/discovery/fake"
+	k8stesting "k8s.io/client-go/testing"
+
+	"github.com/kubeshop/botkube/pkg/config"
+	"github.com/kubeshop/botkube/pkg/events"
+)
+
+func TestDeprecatedAPIChecker_Run(t *testing.T) {
+	tests := []struct {
+		name                    string
+		givenServerVersion      string
+		givenAPIVersion         string
+		givenLastApplied        string
+		expectedRecommendations []string
+	}{
+		{
+			name:               "Deprecated API version",
+			givenServerVersion: "v1.24.3-gke.100",
+			givenAPIVersion:    "batch/v1beta1",
+			expectedRecommendations: []string{
+				"CronJob 'backup' uses API version 'batch/v1beta1' which is deprecated and will be removed in Kubernetes v1.25. Use 'batch/v1' instead.",
+			},
+		},
+		{
+			name:               "Removed API version from last applied configuration",
+			givenServerVersion: "v1.25.0",
+			givenAPIVersion:    "batch/v1",
+			givenLastApplied:   `{"apiVersion":"batch/v1beta1","kind":"CronJob"}`,
+			expectedRecommendations: []string{
+				"CronJob 'backup' uses API version 'batch/v1beta1' which was removed in Kubernetes v1.25. Use 'batch/v1' instead.",
+			},
+		},
+		{
+			name:               "API version not yet deprecated",
+			givenServerVersion: "v1.20.0",
+			givenAPIVersion:    "batch/v1beta1",
+		},
+		{
+			name:               "Supported API version",
+			givenServerVersion: "v1.24.0",
+			givenAPIVersion:    "batch/v1",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			discoveryCli := &fakediscovery.FakeDiscovery{
+				Fake:               &k8stesting.Fake{},
+				FakedServerVersion: &apiversion.Info{GitVersion: tc.givenServerVersion},
+			}
+			logger, _ := logtest.NewNullLogger()
+			checker := NewDeprecatedAPIChecker(logger, discoveryCli)
+
+			unstr := &unstructured.Unstructured{}
+			unstr.SetAPIVersion(tc.givenAPIVersion)
+			unstr.SetKind("CronJob")
+			unstr.SetName("backup")
+			if tc.givenLastApplied != "" {
+				unstr.SetAnnotations(map[string]string{lastAppliedConfigAnnotation: tc.givenLastApplied})
+			}
+
+			event, err := events.New(metav1.ObjectMeta{Name: "backup"}, unstr, config.CreateEvent, "batch/v1/cronjobs", "sample")
+			require.NoError(t, err)
+
+			// when
+			err = checker.Run(context.Background(), unstr, &event)
+
+			// then
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedRecommendations, event.Recommendations)
+		})
+	}
+}
+
+func TestDeprecatedAPIChecker_ServerVersionBackoff(t *testing.T) {
+	// given
+	now := time.Date(2022, 7, 1, 12, 0, 0, 0, time.UTC)
+	discoveryCli := &fakeServerVersionGetter{err: errors.New("connection refused")}
+	logger, _ := logtest.NewNullLogger()
+	checker := NewDeprecatedAPIChecker(logger, discoveryCli)
+	checker.nowFn = func() time.Time { return now }
+
+	// when
+	ver := checker.getServerVersion()
+	ver2 := checker.getServerVersion()
+
+	// then
+	assert.Nil(t, ver)
+	assert.Nil(t, ver2)
+	assert.Equal(t, 1, discoveryCli.calls, "failure should be cached")
+
+	// when
+	now = now.Add(serverVersionInitialBackoff)
+	checker.getServerVersion()
+	now = now.Add(serverVersionInitialBackoff)
+	checker.getServerVersion()
+
+	// then
+	assert.Equal(t, 2, discoveryCli.calls, "backoff should be doubled after another failure")
+
+	// when
+	discoveryCli.err = nil
+	now = now.Add(serverVersionInitialBackoff)
+	ver = checker.getServerVersion()
+	ver2 = checker.getServerVersion()
+
+	// then
+	require.NotNil(t, ver)
+	assert.Equal(t, "1.24.3", ver.String())
+	assert.Equal(t, ver, ver2)
+	assert.Equal(t, 3, discoveryCli.calls)
+}
+
+func TestNextServerVersionBackoff(t *testing.T) {
+	backoff := time.Duration(0)
+	var got []time.Duration
+	for i := 0; i < 7; i++ {
+		backoff = nextServerVersionBackoff(backoff)
+		got = append(got, backoff)
+	}
+
+	assert.Equal(t, []time.Duration{
+		30 * time.Second, time.Minute, 2 * time.Minute, 4 * time.Minute, 8 * time.Minute, 10 * time.Minute, 10 * time.Minute,
+	}, got)
+}
+
+type fakeServerVersionGetter struct {
+	err   error
+	calls int
+}
+
+func (f *fakeServerVersionGetter) ServerVersion() (*apiversion.Info, error) {
+	f.calls++
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &apiversion.Info{GitVersion: "v1.24.3"}, nil
+}
+
+func TestLoadDeprecatedAPIs(t *testing.T) {
+	// when
+	apis, err := loadDeprecatedAPIs()
+
+	// then
+	require.NoError(t, err)
+	require.NotEmpty(t, apis)
+	for _, api := range apis {
+		assert.NotEmpty(t, api.APIVersion)
+		assert.NotEmpty(t, api.Kinds)
+
+		deprecatedIn, err := version.ParseGeneric(api.DeprecatedIn)
+		require.NoError(t, err, api.APIVersion)
+		removedIn, err := version.ParseGeneric(api.RemovedIn)
+		require.NoError(t, err, api.APIVersion)
+		assert.True(t, removedIn.AtLeast(deprecatedIn), api.APIVersion)
+	}
+}
@@ -29,13 +29,14 @@ func NewNodeEventsChecker(log logrus.FieldLogger) *NodeEventsChecker {
 
 // Run filers and modifies event struct
 func (f *NodeEventsChecker) Run(_ context.Context, object interface{}, event *events.Event) error {
-	// Check for Event object
-	if utils.GetObjectTypeMetaData(object).Kind == "Event" {
+	// Run filter only on Node events
+	if event.Kind != "Node" {
 		return nil
 	}
 
-	// Run filter only on Node events
-	if event.Kind != "Node" {
+	// Kubernetes Events about the Node with other reasons are handled as usual
+	isEventObject := utils.GetObjectTypeMetaData(object).Kind == "Event"
+	if isEventObject && event.Reason != NodeNotReady && event.Reason != NodeReady {
 		return nil
 	}
 
@@ -3,12 +3,16 @@ package filters
 import (
 	"context"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/sirupsen/logrus"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/dynamic"
+	corelisters "k8s.io/client-go/listers/core/v1"
 
+	"github.com/kubeshop/botkube/pkg/config"
 	"github.com/kubeshop/botkube/pkg/events"
 	"github.com/kubeshop/botkube/pkg/utils"
 )
@@ -18,18 +22,42 @@ const (
 	DisableAnnotation string = "botkube.io/disable"
 	// ChannelAnnotation is the multichannel support annotation
 	ChannelAnnotation string = "botkube.io/channel"
+	// MinLevelAnnotation is the annotation with the minimal level of the sent events, e.g. `error`
+	MinLevelAnnotation string = "botkube.io/min-level"
+	// EventTypesAnnotation is the annotation with comma-separated types of the sent events, e.g. `create,error`.
+	// Warning Kubernetes Events have the `error` type.
+	EventTypesAnnotation string = "botkube.io/event-types"
+	// MuteUntilAnnotation is the annotation with RFC 3339 timestamp until which the events are not sent
+	MuteUntilAnnotation string = "botkube.io/mute-until"
+	// MentionAnnotation is the annotation with comma-separated `platform:id` entries mentioned for error and critical events,
+	// e.g. `slack:U0123ABC,slack:S0456DEF,discord:987654321,mattermost:john`
+	MentionAnnotation string = "botkube.io/mention"
+
+	annotationPrefix = "botkube.io/"
 )
 
-// ObjectAnnotationChecker add recommendations to the event object if pod created without any labels
+// levelOrder defines the severity order of the event levels.
+var levelOrder = map[config.Level]int{
+	config.Debug:    0,
+	config.Info:     1,
+	config.Warn:     2,
+	config.Error:    3,
+	config.Critical: 4,
+}
+
+// ObjectAnnotationChecker checks botkube.io/* annotations of the object and its Namespace,
+// and skips or redirects the event accordingly. Object annotations override the Namespace ones.
 type ObjectAnnotationChecker struct {
 	log        logrus.FieldLogger
 	dynamicCli dynamic.Interface
 	mapper     meta.RESTMapper
+	nsLister   corelisters.NamespaceLister
+	nowFn      func() time.Time
 }
 
 // NewObjectAnnotationChecker creates a new ObjectAnnotationChecker instance
-func NewObjectAnnotationChecker(log logrus.FieldLogger, dynamicCli dynamic.Interface, mapper meta.RESTMapper) *ObjectAnnotationChecker {
-	return &ObjectAnnotationChecker{log: log, dynamicCli: dynamicCli, mapper: mapper}
+func NewObjectAnnotationChecker(log logrus.FieldLogger, dynamicCli dynamic.Interface, mapper meta.RESTMapper, nsLister corelisters.NamespaceLister) *ObjectAnnotationChecker {
+	return &ObjectAnnotationChecker{log: log, dynamicCli: dynamicCli, mapper: mapper, nsLister: nsLister, nowFn: time.Now}
 }
 
 // Run filters and modifies event struct
@@ -39,6 +67,7 @@ func (f *ObjectAnnotationChecker) Run(ctx context.Context, object interface{}, e
 	if err != nil {
 		return fmt.Errorf("while getting object metadata: %w", err)
 	}
+	obj.Annotations = f.inheritedAnnotations(obj)
 
 	// Check annotations in object
 	if f.isObjectNotifDisabled(obj) {
@@ -46,11 +75,19 @@ func (f *ObjectAnnotationChecker) Run(ctx context.Context, object interface{}, e
 		f.log.Debug("Object Notification Disable through annotations")
 	}
 
+	if f.isEventFilteredOut(obj, event) {
+		event.Skip = true
+	}
+
 	if channel, ok := f.reconfigureChannel(obj); ok {
 		event.Channel = channel
 		f.log.Debugf("Redirecting Event Notifications to channel: %s", channel)
 	}
 
+	if event.Level == config.Error || event.Level == config.Critical {
+		event.Mentions = f.mentions(obj)
+	}
+
 	f.log.Debug("Object annotations filter successful!")
 	return nil
 }
@@ -65,6 +102,87 @@ func (f *ObjectAnnotationChecker) Describe() string {
 	return "Checks if annotations botkube.io/* present in object specs and filters them."
 }
 
+// inheritedAnnotations returns botkube.io/* annotations of the object Namespace overridden by the object annotations.
+func (f *ObjectAnnotationChecker) inheritedAnnotations(obj metaV1.ObjectMeta) map[string]string {
+	if f.nsLister == nil || obj.Namespace == "" {
+		return obj.Annotations
+	}
+
+	ns, err := f.nsLister.Get(obj.Namespace)
+	if err != nil {
+		f.log.Debugf("while getting Namespace %q: %s", obj.Namespace, err.Error())
+		return obj.Annotations
+	}
+
+	out := make(map[string]string)
+	for key, value := range ns.Annotations {
+		if strings.HasPrefix(key, annotationPrefix) {
+			out[key] = value
+		}
+	}
+	for key, value := range obj.Annotations {
+		out[key] = value
+	}
+	return out
+}
+
+// isEventFilteredOut checks annotations botkube.io/min-level, botkube.io/event-types and botkube.io/mute-until
+func (f *ObjectAnnotationChecker) isEventFilteredOut(obj metaV1.ObjectMeta, event *events.Event) bool {
+	if minLevel, ok := obj.Annotations[MinLevelAnnotation]; ok {
+		minOrder, known := levelOrder[config.Level(strings.ToLower(strings.TrimSpace(minLevel)))]
+		if !known {
+			f.log.Warnf("Unknown level %q in %s annotation. Ignoring...", minLevel, MinLevelAnnotation)
+		} else if levelOrder[event.Level] < minOrder {
+			f.log.Debugf("Skipping event with level %q lower than %q", event.Level, minLevel)
+			return true
+		}
+	}
+
+	if eventTypes, ok := obj.Annotations[EventTypesAnnotation]; ok {
+		if !containsEventType(eventTypes, event.Type) {
+			f.log.Debugf("Skipping event with type %q not allowed by %s annotation", event.Type, EventTypesAnnotation)
+			return true
+		}
+	}
+
+	if muteUntil, ok := obj.Annotations[MuteUntilAnnotation]; ok {
+		until, err := time.Parse(time.RFC3339, strings.TrimSpace(muteUntil))
+		if err != nil {
+			f.log.Warnf("Invalid timestamp %q in %s annotation: %s. Ignoring...", muteUntil, MuteUntilAnnotation, err.Error())
+		} else if f.nowFn().Before(until) {
+			f.log.Debugf("Skipping event muted until %s", until)
+			return true
+		}
+	}
+
+	return false
+}
+
+// mentions parses annotation botkube.io/mention and returns mentioned users and groups per communication platform
+func (f *ObjectAnnotationChecker) mentions(obj metaV1.ObjectMeta) map[config.CommPlatformIntegration][]string {
+	value, ok := obj.Annotations[MentionAnnotation]
+	if !ok {
+		return nil
+	}
+
+	out := make(map[config.CommPlatformIntegration][]string)
+	for _, item := range strings.Split(value, ",") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+
+		platform, id, found := strings.Cut(item, ":")
+		if !found || strings.TrimSpace(id) == "" {
+			f.log.Warnf("Invalid entry %q in %s annotation. Expected format: `platform:id`. Ignoring...", item, MentionAnnotation)
+			continue
+		}
+		key := config.CommPlatformIntegration(strings.ToLower(strings.TrimSpace(platform)))
+		out[key] = append(out[key], strings.TrimSpace(id))
+	}
+	return out
+}
+
 // isObjectNotifDisabled checks annotation botkube.io/disable
 // annotation botkube.io/disable disables the event notifications from objects
 func (f *ObjectAnnotationChecker) isObjectNotifDisabled(obj metaV1.ObjectMeta) bool {
@@ -86,3 +204,12 @@ func (f *ObjectAnnotationChecker) reconfigureChannel(obj metaV1.ObjectMeta) (str
 	}
 	return "", false
 }
+
+func containsEventType(commaSeparated string, eventType config.EventType) bool {
+	for _, item := range strings.Split(commaSeparated, ",") {
+		if strings.EqualFold(strings.TrimSpace(item), eventType.String()) {
+			return true
+		}
+	}
+	return false
+}
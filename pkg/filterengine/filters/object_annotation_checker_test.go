@@ -2,9 +2,18 @@ package filters
 
 import (
 	"testing"
+	"time"
 
 	logtest "github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	coreV1 "k8s.io/api/core/v1"
 	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/kubeshop/botkube/pkg/config"
+	"github.com/kubeshop/botkube/pkg/events"
 )
 
 func TestIsObjectNotifDisabled(t *testing.T) {
@@ -21,7 +30,7 @@ func TestIsObjectNotifDisabled(t *testing.T) {
 		name, test := name, test
 		t.Run(name, func(t *testing.T) {
 			log, _ := logtest.NewNullLogger()
-			f := NewObjectAnnotationChecker(log, nil, nil)
+			f := NewObjectAnnotationChecker(log, nil, nil, nil)
 
 			if actual := f.isObjectNotifDisabled(test.annotation); actual != test.expected {
 				t.Errorf("expected: %+v != actual: %+v\n", test.expected, actual)
@@ -45,7 +54,7 @@ func TestReconfigureChannel(t *testing.T) {
 		name, test := name, test
 		t.Run(name, func(t *testing.T) {
 			log, _ := logtest.NewNullLogger()
-			f := NewObjectAnnotationChecker(log, nil, nil)
+			f := NewObjectAnnotationChecker(log, nil, nil, nil)
 
 			if actualChannel, actualBool := f.reconfigureChannel(test.objectMeta); actualBool != test.expectedBool {
 				if actualChannel != test.expectedChannel {
@@ -55,3 +64,143 @@ func TestReconfigureChannel(t *testing.T) {
 		})
 	}
 }
+
+func TestNamespaceAnnotationsInheritance(t *testing.T) {
+	now := time.Date(2022, 7, 1, 12, 0, 0, 0, time.UTC)
+	ns := &coreV1.Namespace{
+		ObjectMeta: metaV1.ObjectMeta{
+			Name: "team-a",
+			Annotations: map[string]string{
+				ChannelAnnotation:    "team-a",
+				MinLevelAnnotation:   "error",
+				EventTypesAnnotation: "create, error",
+				"foo":                "bar",
+			},
+		},
+	}
+
+	tests := []struct {
+		name                string
+		givenAnnotations    map[string]string
+		givenType           config.EventType
+		givenLevel          config.Level
+		expectedSkip        bool
+		expectedAnnotations map[string]string
+	}{
+		{
+			name:         "Namespace annotations are inherited",
+			givenType:    config.ErrorEvent,
+			givenLevel:   config.Error,
+			expectedSkip: false,
+			expectedAnnotations: map[string]string{
+				ChannelAnnotation:    "team-a",
+				MinLevelAnnotation:   "error",
+				EventTypesAnnotation: "create, error",
+			},
+		},
+		{
+			name:         "Level lower than the Namespace minimal level",
+			givenType:    config.CreateEvent,
+			givenLevel:   config.Info,
+			expectedSkip: true,
+		},
+		{
+			name:             "Object annotations override the Namespace ones",
+			givenAnnotations: map[string]string{MinLevelAnnotation: "info", EventTypesAnnotation: "create,update"},
+			givenType:        config.UpdateEvent,
+			givenLevel:       config.Warn,
+			expectedSkip:     false,
+		},
+		{
+			name:             "Event type not allowed",
+			givenAnnotations: map[string]string{EventTypesAnnotation: "create"},
+			givenType:        config.ErrorEvent,
+			givenLevel:       config.Error,
+			expectedSkip:     true,
+		},
+		{
+			name:             "Muted object",
+			givenAnnotations: map[string]string{MuteUntilAnnotation: "2022-07-01T13:00:00Z"},
+			givenType:        config.ErrorEvent,
+			givenLevel:       config.Error,
+			expectedSkip:     true,
+		},
+		{
+			name:             "Mute expired",
+			givenAnnotations: map[string]string{MuteUntilAnnotation: "2022-07-01T11:00:00Z"},
+			givenType:        config.ErrorEvent,
+			givenLevel:       config.Error,
+			expectedSkip:     false,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			log, _ := logtest.NewNullLogger()
+			f := NewObjectAnnotationChecker(log, nil, nil, fixNamespaceLister(t, ns))
+			f.nowFn = func() time.Time { return now }
+
+			obj := metaV1.ObjectMeta{Name: "web", Namespace: "team-a", Annotations: tc.givenAnnotations}
+			event := &events.Event{Type: tc.givenType, Level: tc.givenLevel}
+
+			// when
+			obj.Annotations = f.inheritedAnnotations(obj)
+			skip := f.isEventFilteredOut(obj, event)
+
+			// then
+			assert.Equal(t, tc.expectedSkip, skip)
+			if tc.expectedAnnotations != nil {
+				assert.Equal(t, tc.expectedAnnotations, obj.Annotations)
+			}
+		})
+	}
+}
+
+func TestMentions(t *testing.T) {
+	tests := []struct {
+		name       string
+		annotation string
+		expected   map[config.CommPlatformIntegration][]string
+	}{
+		{
+			name:       "Mentions per platform",
+			annotation: "slack:U0123ABC, slack:S0456DEF,Discord:987654321,mattermost:john",
+			expected: map[config.CommPlatformIntegration][]string{
+				config.SlackCommPlatformIntegration:      {"U0123ABC", "S0456DEF"},
+				config.DiscordCommPlatformIntegration:    {"987654321"},
+				config.MattermostCommPlatformIntegration: {"john"},
+			},
+		},
+		{
+			name:       "Invalid entries are ignored",
+			annotation: "U0123ABC,slack:,mattermost:john",
+			expected: map[config.CommPlatformIntegration][]string{
+				config.MattermostCommPlatformIntegration: {"john"},
+			},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			log, _ := logtest.NewNullLogger()
+			f := NewObjectAnnotationChecker(log, nil, nil, nil)
+			obj := metaV1.ObjectMeta{Annotations: map[string]string{MentionAnnotation: tc.annotation}}
+
+			// when
+			mentions := f.mentions(obj)
+
+			// then
+			assert.Equal(t, tc.expected, mentions)
+		})
+	}
+}
+
+func fixNamespaceLister(t *testing.T, namespaces ...*coreV1.Namespace) corelisters.NamespaceLister {
+	t.Helper()
+
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+	for _, ns := range namespaces {
+		require.NoError(t, indexer.Add(ns))
+	}
+	return corelisters.NewNamespaceLister(indexer)
+}
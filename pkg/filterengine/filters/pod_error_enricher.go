@@ -0,0 +1,328 @@
+package filters
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/sirupsen/logrus"
+	coreV1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/kubeshop/botkube/pkg/config"
+	"github.com/kubeshop/botkube/pkg/events"
+	"github.com/kubeshop/botkube/pkg/utils"
+)
+
+const (
+	defaultEnrichmentLogLines = 20
+	defaultEnrichmentMaxBytes = 2000
+	maxDescribeEvents         = 5
+	redactedValue             = "<redacted>"
+	truncatedMarker           = "[...]"
+)
+
+var (
+	defaultEnrichmentReasons = []string{"BackOff", "Failed"}
+
+	// containerFieldPathRegex matches the container name in the involvedObject.fieldPath of the Event,
+	// e.g. `spec.containers{app}`
+	containerFieldPathRegex = regexp.MustCompile(`^spec\.(?:initContainers|containers|ephemeralContainers)\{(.+)\}$`)
+
+	// builtInRedactions replaces values of the well-known secrets with the redacted value.
+	builtInRedactions = []struct {
+		regex       *regexp.Regexp
+		replacement string
+	}{
+		{
+			regex:       regexp.MustCompile(`(?i)(authorization:\s*(?:bearer|basic)\s+)\S+`),
+			replacement: "${1}" + redactedValue,
+		},
+		{
+			regex:       regexp.MustCompile(`(?i)(bearer\s+)[a-z0-9\-._~+/]+=*`),
+			replacement: "${1}" + redactedValue,
+		},
+		{
+			regex:       regexp.MustCompile(`(?i)((?:password|passwd|pwd|secret|token|api[_-]?key|access[_-]?key)["']?\s*[:=]\s*["']?)[^\s"',;]+`),
+			replacement: "${1}" + redactedValue,
+		},
+		{
+			regex:       regexp.MustCompile(`\b(?:AKIA|ASIA)[0-9A-Z]{16}\b`),
+			replacement: redactedValue,
+		},
+	}
+)
+
+// PodErrorEnricher appends recent logs of the failing container and the condensed describe output
+// of the Pod to the Pod warning events, so the responders don't need to run kubectl to find out the cause.
+type PodErrorEnricher struct {
+	log            logrus.FieldLogger
+	k8sCli         kubernetes.Interface
+	cfg            config.PodErrorEnrichmentFilter
+	redactPatterns []*regexp.Regexp
+}
+
+// NewPodErrorEnricher creates a new PodErrorEnricher instance
+func NewPodErrorEnricher(log logrus.FieldLogger, k8sCli kubernetes.Interface, cfg config.PodErrorEnrichmentFilter) *PodErrorEnricher {
+	if len(cfg.Reasons) == 0 {
+		cfg.Reasons = defaultEnrichmentReasons
+	}
+	if cfg.LogLines <= 0 {
+		cfg.LogLines = defaultEnrichmentLogLines
+	}
+	if cfg.MaxBytes <= 0 {
+		cfg.MaxBytes = defaultEnrichmentMaxBytes
+	}
+
+	var redactPatterns []*regexp.Regexp
+	for _, pattern := range cfg.RedactPatterns {
+		regex, err := regexp.Compile(pattern)
+		if err != nil {
+			log.Errorf("while compiling redact pattern %q: %s. Skipping...", pattern, err.Error())
+			continue
+		}
+		redactPatterns = append(redactPatterns, regex)
+	}
+
+	return &PodErrorEnricher{log: log, k8sCli: k8sCli, cfg: cfg, redactPatterns: redactPatterns}
+}
+
+// Run filers and modifies event struct
+func (f *PodErrorEnricher) Run(ctx context.Context, object interface{}, event *events.Event) error {
+	if utils.GetObjectTypeMetaData(object).Kind != "Event" || event.Kind != "Pod" || event.Skip {
+		return nil
+	}
+	if event.Type != config.WarningEvent && event.Type != config.ErrorEvent {
+		return nil
+	}
+	if !f.isReasonEnabled(event.Reason) {
+		return nil
+	}
+
+	var eventObj coreV1.Event
+	if err := utils.TransformIntoTypedObject(object.(*unstructured.Unstructured), &eventObj); err != nil {
+		return fmt.Errorf("while transforming object type %T into type: %T: %w", object, eventObj, err)
+	}
+
+	pod, err := f.k8sCli.CoreV1().Pods(event.Namespace).Get(ctx, event.Name, metaV1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		f.log.Debugf("Pod %s/%s not found. Skipping enrichment...", event.Namespace, event.Name)
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("while getting Pod %s/%s: %w", event.Namespace, event.Name, err)
+	}
+
+	status, found := failingContainerStatus(pod, eventObj.InvolvedObject.FieldPath)
+	if found {
+		event.Messages = append(event.Messages, f.containerLogs(ctx, pod, status)...)
+	}
+
+	describe, err := f.describePod(ctx, pod)
+	if err != nil {
+		return err
+	}
+	event.Messages = append(event.Messages, f.format("Pod details", describe))
+
+	f.log.Debug("Pod error enrichment successful!")
+	return nil
+}
+
+// Name returns the filter's name
+func (f *PodErrorEnricher) Name() string {
+	return "PodErrorEnricher"
+}
+
+// Describe describes the filter
+func (f *PodErrorEnricher) Describe() string {
+	return "Appends recent container logs and condensed describe output to Pod error events."
+}
+
+func (f *PodErrorEnricher) isReasonEnabled(reason string) bool {
+	for _, r := range f.cfg.Reasons {
+		if r == reason {
+			return true
+		}
+	}
+	return false
+}
+
+func (f *PodErrorEnricher) containerLogs(ctx context.Context, pod *coreV1.Pod, status coreV1.ContainerStatus) []string {
+	var out []string
+
+	// Waiting containers don't have any current logs
+	if status.State.Waiting == nil {
+		logs, err := f.fetchLogs(ctx, pod, status.Name, false)
+		if err != nil {
+			f.log.Debugf("while getting logs of Container %q in Pod %s/%s: %s", status.Name, pod.Namespace, pod.Name, err.Error())
+		} else if logs != "" {
+			out = append(out, f.format(fmt.Sprintf("Logs of Container '%s'", status.Name), logs))
+		}
+	}
+
+	if status.LastTerminationState.Terminated != nil {
+		logs, err := f.fetchLogs(ctx, pod, status.Name, true)
+		if err != nil {
+			f.log.Debugf("while getting previous logs of Container %q in Pod %s/%s: %s", status.Name, pod.Namespace, pod.Name, err.Error())
+		} else if logs != "" {
+			out = append(out, f.format(fmt.Sprintf("Previous logs of Container '%s'", status.Name), logs))
+		}
+	}
+
+	return out
+}
+
+func (f *PodErrorEnricher) fetchLogs(ctx context.Context, pod *coreV1.Pod, container string, previous bool) (string, error) {
+	tailLines := f.cfg.LogLines
+	limitBytes := int64(f.cfg.MaxBytes)
+	req := f.k8sCli.CoreV1().Pods(pod.Namespace).GetLogs(pod.Name, &coreV1.PodLogOptions{
+		Container:  container,
+		Previous:   previous,
+		TailLines:  &tailLines,
+		LimitBytes: &limitBytes,
+	})
+	logs, err := req.DoRaw(ctx)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(logs)), nil
+}
+
+func (f *PodErrorEnricher) describePod(ctx context.Context, pod *coreV1.Pod) (string, error) {
+	var out strings.Builder
+
+	fmt.Fprintf(&out, "Status: %s\n", pod.Status.Phase)
+	if pod.Status.Reason != "" {
+		fmt.Fprintf(&out, "Reason: %s\n", pod.Status.Reason)
+	}
+
+	if len(pod.Status.Conditions) > 0 {
+		out.WriteString("Conditions:\n")
+		for _, cond := range pod.Status.Conditions {
+			fmt.Fprintf(&out, "  %s: %s\n", cond.Type, cond.Status)
+		}
+	}
+
+	statuses := append(append([]coreV1.ContainerStatus{}, pod.Status.InitContainerStatuses...), pod.Status.ContainerStatuses...)
+	if len(statuses) > 0 {
+		out.WriteString("Containers:\n")
+		for _, status := range statuses {
+			fmt.Fprintf(&out, "  %s:\n", status.Name)
+			fmt.Fprintf(&out, "    State: %s\n", describeContainerState(status.State))
+			if status.LastTerminationState != (coreV1.ContainerState{}) {
+				fmt.Fprintf(&out, "    Last State: %s\n", describeContainerState(status.LastTerminationState))
+			}
+			fmt.Fprintf(&out, "    Ready: %t\n", status.Ready)
+			fmt.Fprintf(&out, "    Restart Count: %d\n", status.RestartCount)
+		}
+	}
+
+	list, err := f.k8sCli.CoreV1().Events(pod.Namespace).List(ctx, metaV1.ListOptions{
+		FieldSelector: fields.SelectorFromSet(fields.Set{
+			"involvedObject.kind": "Pod",
+			"involvedObject.name": pod.Name,
+		}).String(),
+	})
+	if err != nil {
+		return "", fmt.Errorf("while listing Events for Pod %s/%s: %w", pod.Namespace, pod.Name, err)
+	}
+
+	recentEvents := list.Items
+	sort.Slice(recentEvents, func(i, j int) bool {
+		return eventTime(recentEvents[i]).Before(eventTime(recentEvents[j]))
+	})
+	if len(recentEvents) > maxDescribeEvents {
+		recentEvents = recentEvents[len(recentEvents)-maxDescribeEvents:]
+	}
+	if len(recentEvents) > 0 {
+		out.WriteString("Events:\n")
+		for _, e := range recentEvents {
+			fmt.Fprintf(&out, "  %s %s: %s\n", e.Type, e.Reason, e.Message)
+		}
+	}
+
+	return strings.TrimSpace(out.String()), nil
+}
+
+// format redacts the content, truncates it to the configured size and wraps it in a code block.
+func (f *PodErrorEnricher) format(title, content string) string {
+	content = f.redact(content)
+	if len(content) > f.cfg.MaxBytes {
+		// keep the tail, as the most recent lines are the most relevant ones
+		start := len(content) - f.cfg.MaxBytes
+		if idx := strings.IndexByte(content[start:], '\n'); idx >= 0 {
+			start += idx + 1
+		}
+		for start < len(content) && !utf8.RuneStart(content[start]) {
+			start++
+		}
+		content = truncatedMarker + "\n" + content[start:]
+	}
+	return fmt.Sprintf("%s:\n```\n%s\n```", title, content)
+}
+
+func (f *PodErrorEnricher) redact(content string) string {
+	for _, r := range builtInRedactions {
+		content = r.regex.ReplaceAllString(content, r.replacement)
+	}
+	for _, regex := range f.redactPatterns {
+		content = regex.ReplaceAllString(content, redactedValue)
+	}
+	return content
+}
+
+// failingContainerStatus returns the status of the container referenced by the Event field path.
+// If the field path doesn't reference any container, the first container which is not ready is returned.
+func failingContainerStatus(pod *coreV1.Pod, fieldPath string) (coreV1.ContainerStatus, bool) {
+	statuses := append(append([]coreV1.ContainerStatus{}, pod.Status.InitContainerStatuses...), pod.Status.ContainerStatuses...)
+
+	if matches := containerFieldPathRegex.FindStringSubmatch(fieldPath); len(matches) == 2 {
+		for _, status := range statuses {
+			if status.Name == matches[1] {
+				return status, true
+			}
+		}
+	}
+
+	for _, status := range statuses {
+		if !status.Ready && (status.State.Waiting != nil || status.State.Terminated != nil || status.RestartCount > 0) {
+			return status, true
+		}
+	}
+
+	return coreV1.ContainerStatus{}, false
+}
+
+func describeContainerState(state coreV1.ContainerState) string {
+	switch {
+	case state.Waiting != nil:
+		if state.Waiting.Message != "" {
+			return fmt.Sprintf("Waiting (%s: %s)", state.Waiting.Reason, state.Waiting.Message)
+		}
+		return fmt.Sprintf("Waiting (%s)", state.Waiting.Reason)
+	case state.Terminated != nil:
+		return fmt.Sprintf("Terminated (%s, exit code %d)", state.Terminated.Reason, state.Terminated.ExitCode)
+	case state.Running != nil:
+		return fmt.Sprintf("Running (since %s)", state.Running.StartedAt.UTC().Format("2006-01-02T15:04:05Z"))
+	default:
+		return "Unknown"
+	}
+}
+
+func eventTime(e coreV1.Event) time.Time {
+	if !e.LastTimestamp.IsZero() {
+		return e.LastTimestamp.Time
+	}
+	if e.Series != nil {
+		return e.Series.LastObservedTime.Time
+	}
+	return e.EventTime.Time
+}
@@ -0,0 +1,192 @@
+package filters
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+	coreV1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/kubeshop/botkube/pkg/config"
+	"github.com/kubeshop/botkube/pkg/events"
+	"github.com/kubeshop/botkube/pkg/utils"
+)
+
+// dangerousCapabilities contains capabilities which shouldn't be added to containers.
+var dangerousCapabilities = map[coreV1.Capability]struct{}{
+	"ALL":        {},
+	"SYS_ADMIN":  {},
+	"SYS_MODULE": {},
+	"SYS_PTRACE": {},
+	"SYS_RAWIO":  {},
+	"SYS_BOOT":   {},
+	"NET_ADMIN":  {},
+}
+
+// PodSecurityChecker adds warnings to the event object if the Pod or workload template
+// doesn't follow the Pod security best practices.
+type PodSecurityChecker struct {
+	log logrus.FieldLogger
+	cfg config.PodSecurityFilter
+}
+
+// NewPodSecurityChecker creates a new PodSecurityChecker instance
+func NewPodSecurityChecker(log logrus.FieldLogger, cfg config.PodSecurityFilter) *PodSecurityChecker {
+	return &PodSecurityChecker{log: log, cfg: cfg}
+}
+
+// Run filers and modifies event struct
+func (f *PodSecurityChecker) Run(_ context.Context, object interface{}, event *events.Event) error {
+	if event.Type != config.CreateEvent || utils.GetObjectTypeMetaData(object).Kind == "Event" {
+		return nil
+	}
+	if !f.isNamespaceIncluded(event.Namespace) {
+		return nil
+	}
+
+	unstrObj, ok := object.(*unstructured.Unstructured)
+	if !ok {
+		return fmt.Errorf("cannot convert type %T into *unstructured.Unstructured", object)
+	}
+
+	// Pods created by workloads are checked on the workload level
+	if isPodOwnedByWorkload(unstrObj) {
+		return nil
+	}
+
+	podSpec, err := podSpecFromObject(unstrObj)
+	if err != nil {
+		return err
+	}
+	if podSpec == nil {
+		return nil
+	}
+
+	exempted := f.exemptedChecks(event.Namespace)
+	enabled := func(check config.PodSecurityCheck) bool {
+		_, found := exempted[check]
+		return !found
+	}
+	warn := func(format string, args ...interface{}) {
+		event.Warnings = append(event.Warnings, fmt.Sprintf(format, args...))
+	}
+
+	if enabled(config.PodSecurityCheckHostNetwork) && podSpec.HostNetwork {
+		warn("%s '%s' uses the host network.", event.Kind, event.Name)
+	}
+	if enabled(config.PodSecurityCheckHostPID) && podSpec.HostPID {
+		warn("%s '%s' shares the host PID namespace.", event.Kind, event.Name)
+	}
+	if enabled(config.PodSecurityCheckHostPath) {
+		for _, v := range podSpec.Volumes {
+			if v.HostPath != nil {
+				warn("%s '%s' mounts the hostPath volume '%s' (%s).", event.Kind, event.Name, v.Name, v.HostPath.Path)
+			}
+		}
+	}
+
+	var containers []coreV1.Container
+	containers = append(containers, podSpec.InitContainers...)
+	containers = append(containers, podSpec.Containers...)
+	for _, c := range containers {
+		sc := c.SecurityContext
+		if sc == nil {
+			sc = &coreV1.SecurityContext{}
+		}
+
+		if enabled(config.PodSecurityCheckPrivileged) && sc.Privileged != nil && *sc.Privileged {
+			warn("Container '%s' in %s '%s' runs in privileged mode.", c.Name, event.Kind, event.Name)
+		}
+		if enabled(config.PodSecurityCheckRunAsRoot) && canRunAsRoot(podSpec.SecurityContext, sc) {
+			warn("Container '%s' in %s '%s' can run as root user.", c.Name, event.Kind, event.Name)
+		}
+		if enabled(config.PodSecurityCheckCapabilities) && sc.Capabilities != nil {
+			for _, capability := range sc.Capabilities.Add {
+				if _, found := dangerousCapabilities[coreV1.Capability(strings.ToUpper(string(capability)))]; found {
+					warn("Container '%s' in %s '%s' adds the '%s' capability.", c.Name, event.Kind, event.Name, capability)
+				}
+			}
+		}
+		if enabled(config.PodSecurityCheckReadOnlyRootFilesystem) && (sc.ReadOnlyRootFilesystem == nil || !*sc.ReadOnlyRootFilesystem) {
+			warn("Container '%s' in %s '%s' doesn't have read-only root filesystem.", c.Name, event.Kind, event.Name)
+		}
+	}
+
+	f.log.Debug("Pod security filter successful!")
+	return nil
+}
+
+// Name returns the filter's name
+func (f *PodSecurityChecker) Name() string {
+	return "PodSecurityChecker"
+}
+
+// Describe describes the filter
+func (f *PodSecurityChecker) Describe() string {
+	return "Checks and adds warnings if the Pod spec doesn't follow the Pod security best practices."
+}
+
+// isNamespaceIncluded returns true if the checks should be run for a given namespace.
+// All namespaces are included if the list of included namespaces is empty.
+func (f *PodSecurityChecker) isNamespaceIncluded(namespace string) bool {
+	include := f.cfg.Namespaces.Include
+	if len(include) == 0 || (len(include) == 1 && include[0] == "all") {
+		return !isNamespaceIgnored(config.Namespaces{Include: []string{"all"}, Ignore: f.cfg.Namespaces.Ignore}, namespace)
+	}
+
+	for _, ns := range include {
+		if ns == namespace {
+			return true
+		}
+	}
+	return false
+}
+
+// exemptedChecks returns checks disabled for a given namespace.
+// If the matching exemption doesn't specify any check, all checks are disabled.
+func (f *PodSecurityChecker) exemptedChecks(namespace string) map[config.PodSecurityCheck]struct{} {
+	out := map[config.PodSecurityCheck]struct{}{}
+	for _, exemption := range f.cfg.Exemptions {
+		if !utils.NamespaceMatches(exemption.Namespace, namespace) {
+			continue
+		}
+
+		checks := exemption.Checks
+		if len(checks) == 0 {
+			checks = []config.PodSecurityCheck{
+				config.PodSecurityCheckPrivileged,
+				config.PodSecurityCheckHostNetwork,
+				config.PodSecurityCheckHostPID,
+				config.PodSecurityCheckHostPath,
+				config.PodSecurityCheckRunAsRoot,
+				config.PodSecurityCheckCapabilities,
+				config.PodSecurityCheckReadOnlyRootFilesystem,
+			}
+		}
+		for _, check := range checks {
+			out[check] = struct{}{}
+		}
+	}
+	return out
+}
+
+// canRunAsRoot returns true if the container isn't forced to run as non-root user.
+// Container security context takes precedence over the Pod one.
+func canRunAsRoot(podSC *coreV1.PodSecurityContext, sc *coreV1.SecurityContext) bool {
+	runAsUser, runAsNonRoot := sc.RunAsUser, sc.RunAsNonRoot
+	if podSC != nil {
+		if runAsUser == nil {
+			runAsUser = podSC.RunAsUser
+		}
+		if runAsNonRoot == nil {
+			runAsNonRoot = podSC.RunAsNonRoot
+		}
+	}
+
+	if runAsUser != nil {
+		return *runAsUser == 0
+	}
+	return runAsNonRoot == nil || !*runAsNonRoot
+}
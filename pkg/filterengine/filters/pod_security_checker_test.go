@@ -0,0 +1,152 @@
+package filters
+
+import (
+	"context"
+	"testing"
+
+	logtest "github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	coreV1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/kubeshop/botkube/pkg/config"
+	"github.com/kubeshop/botkube/pkg/events"
+)
+
+func TestPodSecurityChecker_Run(t *testing.T) {
+	privileged, readOnly, nonRoot := true, true, true
+
+	insecurePod := coreV1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default"},
+		Spec: coreV1.PodSpec{
+			HostNetwork: true,
+			HostPID:     true,
+			Volumes: []coreV1.Volume{
+				{
+					Name:         "docker-sock",
+					VolumeSource: coreV1.VolumeSource{HostPath: &coreV1.HostPathVolumeSource{Path: "/var/run/docker.sock"}},
+				},
+			},
+			Containers: []coreV1.Container{
+				{
+					Name: "nginx",
+					SecurityContext: &coreV1.SecurityContext{
+						Privileged: &privileged,
+						Capabilities: &coreV1.Capabilities{
+							Add: []coreV1.Capability{"SYS_ADMIN", "CHOWN"},
+						},
+					},
+				},
+			},
+		},
+	}
+	securePod := coreV1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default"},
+		Spec: coreV1.PodSpec{
+			SecurityContext: &coreV1.PodSecurityContext{RunAsNonRoot: &nonRoot},
+			Containers: []coreV1.Container{
+				{
+					Name:            "nginx",
+					SecurityContext: &coreV1.SecurityContext{ReadOnlyRootFilesystem: &readOnly},
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name             string
+		givenCfg         config.PodSecurityFilter
+		givenPod         coreV1.Pod
+		expectedWarnings []string
+	}{
+		{
+			name:     "Insecure Pod",
+			givenPod: insecurePod,
+			expectedWarnings: []string{
+				"Pod 'web' uses the host network.",
+				"Pod 'web' shares the host PID namespace.",
+				"Pod 'web' mounts the hostPath volume 'docker-sock' (/var/run/docker.sock).",
+				"Container 'nginx' in Pod 'web' runs in privileged mode.",
+				"Container 'nginx' in Pod 'web' can run as root user.",
+				"Container 'nginx' in Pod 'web' adds the 'SYS_ADMIN' capability.",
+				"Container 'nginx' in Pod 'web' doesn't have read-only root filesystem.",
+			},
+		},
+		{
+			name:             "Secure Pod",
+			givenPod:         securePod,
+			expectedWarnings: nil,
+		},
+		{
+			name: "Selected checks exempted in namespace",
+			givenCfg: config.PodSecurityFilter{
+				Exemptions: []config.PodSecurityExemption{
+					{
+						Namespace: "def*",
+						Checks: []config.PodSecurityCheck{
+							config.PodSecurityCheckHostNetwork,
+							config.PodSecurityCheckHostPID,
+							config.PodSecurityCheckHostPath,
+							config.PodSecurityCheckRunAsRoot,
+							config.PodSecurityCheckReadOnlyRootFilesystem,
+						},
+					},
+				},
+			},
+			givenPod: insecurePod,
+			expectedWarnings: []string{
+				"Container 'nginx' in Pod 'web' runs in privileged mode.",
+				"Container 'nginx' in Pod 'web' adds the 'SYS_ADMIN' capability.",
+			},
+		},
+		{
+			name: "All checks exempted in namespace",
+			givenCfg: config.PodSecurityFilter{
+				Exemptions: []config.PodSecurityExemption{{Namespace: "default"}},
+			},
+			givenPod:         insecurePod,
+			expectedWarnings: nil,
+		},
+		{
+			name: "Ignored namespace",
+			givenCfg: config.PodSecurityFilter{
+				Namespaces: config.Namespaces{Include: []string{"all"}, Ignore: []string{"default"}},
+			},
+			givenPod:         insecurePod,
+			expectedWarnings: nil,
+		},
+		{
+			name: "Not included namespace",
+			givenCfg: config.PodSecurityFilter{
+				Namespaces: config.Namespaces{Include: []string{"prod"}},
+			},
+			givenPod:         insecurePod,
+			expectedWarnings: nil,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			logger, _ := logtest.NewNullLogger()
+			checker := NewPodSecurityChecker(logger, tc.givenCfg)
+
+			tc.givenPod.TypeMeta = metav1.TypeMeta{Kind: "Pod", APIVersion: "v1"}
+			unstrObj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&tc.givenPod)
+			require.NoError(t, err)
+			unstr := &unstructured.Unstructured{Object: unstrObj}
+
+			event, err := events.New(tc.givenPod.ObjectMeta, unstr, config.CreateEvent, "v1/pods", "sample")
+			require.NoError(t, err)
+
+			// when
+			err = checker.Run(context.Background(), unstr, &event)
+
+			// then
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedWarnings, event.Warnings)
+		})
+	}
+}
@@ -0,0 +1,212 @@
+package filters
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/open-policy-agent/opa/rego"
+	"github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/kubeshop/botkube/pkg/config"
+	"github.com/kubeshop/botkube/pkg/events"
+	"github.com/kubeshop/botkube/pkg/utils"
+)
+
+const (
+	defaultRegoPackage = "botkube"
+	regoFileExt        = ".rego"
+	regoTestFileSuffix = "_test.rego"
+
+	// regoPoliciesCheckInterval defines how often the policy directory is checked for changes.
+	regoPoliciesCheckInterval = 30 * time.Second
+
+	regoDenyRule = "deny"
+	regoWarnRule = "warn"
+)
+
+// RegoPolicyChecker evaluates Rego policies against created and updated objects.
+// Messages returned by the `deny` rule are added as event warnings,
+// and messages returned by the `warn` rule are added as event recommendations.
+type RegoPolicyChecker struct {
+	log   logrus.FieldLogger
+	cfg   config.RegoPolicyFilter
+	nowFn func() time.Time
+	mu    sync.Mutex
+
+	query       *rego.PreparedEvalQuery
+	fingerprint string
+	lastCheck   time.Time
+}
+
+// NewRegoPolicyChecker creates a new RegoPolicyChecker instance
+func NewRegoPolicyChecker(log logrus.FieldLogger, cfg config.RegoPolicyFilter) *RegoPolicyChecker {
+	if cfg.Package == "" {
+		cfg.Package = defaultRegoPackage
+	}
+	return &RegoPolicyChecker{log: log, cfg: cfg, nowFn: time.Now}
+}
+
+// Run filters and modifies event struct
+func (f *RegoPolicyChecker) Run(ctx context.Context, object interface{}, event *events.Event) error {
+	if event.Type != config.CreateEvent && event.Type != config.UpdateEvent {
+		return nil
+	}
+	if utils.GetObjectTypeMetaData(object).Kind == "Event" {
+		return nil
+	}
+
+	unstrObj, ok := object.(*unstructured.Unstructured)
+	if !ok {
+		return fmt.Errorf("cannot convert type %T into *unstructured.Unstructured", object)
+	}
+
+	query, err := f.preparedQuery(ctx)
+	if err != nil {
+		return fmt.Errorf("while loading Rego policies: %w", err)
+	}
+	if query == nil {
+		// no policies loaded
+		return nil
+	}
+
+	results, err := query.Eval(ctx, rego.EvalInput(unstrObj.Object))
+	if err != nil {
+		return fmt.Errorf("while evaluating Rego policies: %w", err)
+	}
+
+	for _, result := range results {
+		for _, expr := range result.Expressions {
+			rules, ok := expr.Value.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			event.Warnings = append(event.Warnings, regoMessages(rules[regoDenyRule])...)
+			event.Recommendations = append(event.Recommendations, regoMessages(rules[regoWarnRule])...)
+		}
+	}
+
+	f.log.Debug("Rego policy filter successful!")
+	return nil
+}
+
+// Name returns the filter's name
+func (f *RegoPolicyChecker) Name() string {
+	return "RegoPolicyChecker"
+}
+
+// Describe describes the filter
+func (f *RegoPolicyChecker) Describe() string {
+	return "Evaluates Rego policies and adds warnings and recommendations based on 'deny' and 'warn' rules."
+}
+
+// preparedQuery returns the prepared query for currently loaded policies.
+// Policies are reloaded if the files in the policy directory changed since the last check.
+func (f *RegoPolicyChecker) preparedQuery(ctx context.Context) (*rego.PreparedEvalQuery, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	now := f.nowFn()
+	if !f.lastCheck.IsZero() && now.Sub(f.lastCheck) < regoPoliciesCheckInterval {
+		return f.query, nil
+	}
+	f.lastCheck = now
+
+	files, fingerprint, err := f.listPolicyFiles()
+	if err != nil {
+		return nil, err
+	}
+	if fingerprint == f.fingerprint {
+		return f.query, nil
+	}
+
+	f.log.Infof("Loading %d Rego policies from %q", len(files), f.cfg.Path)
+	if len(files) == 0 {
+		f.query, f.fingerprint = nil, fingerprint
+		return nil, nil
+	}
+
+	opts := []func(*rego.Rego){
+		rego.Query(fmt.Sprintf("data.%s", f.cfg.Package)),
+	}
+	for _, file := range files {
+		// #nosec G304
+		content, err := os.ReadFile(file)
+		if err != nil {
+			return nil, fmt.Errorf("while reading policy file %q: %w", file, err)
+		}
+		opts = append(opts, rego.Module(filepath.Base(file), string(content)))
+	}
+
+	query, err := rego.New(opts...).PrepareForEval(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("while compiling policies: %w", err)
+	}
+
+	f.query, f.fingerprint = &query, fingerprint
+	return f.query, nil
+}
+
+// listPolicyFiles returns paths to policy files and their fingerprint based on size and modification time.
+// Hidden files and directories, such as the `..data` symlink in mounted ConfigMaps, are skipped.
+func (f *RegoPolicyChecker) listPolicyFiles() ([]string, string, error) {
+	entries, err := os.ReadDir(f.cfg.Path)
+	if err != nil {
+		return nil, "", fmt.Errorf("while reading policy directory: %w", err)
+	}
+
+	var (
+		files       []string
+		fingerprint strings.Builder
+	)
+	for _, entry := range entries {
+		name := entry.Name()
+		if strings.HasPrefix(name, ".") || !strings.HasSuffix(name, regoFileExt) || strings.HasSuffix(name, regoTestFileSuffix) {
+			continue
+		}
+
+		path := filepath.Join(f.cfg.Path, name)
+		// os.Stat follows symlinks used by mounted ConfigMaps
+		info, err := os.Stat(path)
+		if err != nil {
+			return nil, "", fmt.Errorf("while getting policy file info: %w", err)
+		}
+		if info.IsDir() {
+			continue
+		}
+
+		files = append(files, path)
+		fmt.Fprintf(&fingerprint, "%s:%d:%d;", name, info.Size(), info.ModTime().UnixNano())
+	}
+
+	return files, fingerprint.String(), nil
+}
+
+// regoMessages converts the result of the Rego rule into messages.
+// The rule can return either strings or objects with the `msg` property.
+func regoMessages(rule interface{}) []string {
+	items, ok := rule.([]interface{})
+	if !ok {
+		return nil
+	}
+
+	var out []string
+	for _, item := range items {
+		switch val := item.(type) {
+		case string:
+			out = append(out, val)
+		case map[string]interface{}:
+			if msg, ok := val["msg"].(string); ok {
+				out = append(out, msg)
+			}
+		}
+	}
+	sort.Strings(out)
+	return out
+}
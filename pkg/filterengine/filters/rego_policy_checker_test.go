@@ -0,0 +1,128 @@
+package filters
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	logtest "github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/kubeshop/botkube/pkg/config"
+	"github.com/kubeshop/botkube/pkg/events"
+)
+
+func TestRegoPolicyChecker_Run(t *testing.T) {
+	// given
+	policyDir := t.TempDir()
+	writePolicy(t, policyDir, "replicas.rego", heredoc.Doc(`
+		package botkube
+
+		deny[msg] {
+			input.kind == "Deployment"
+			input.spec.replicas < 2
+			msg := sprintf("Deployment '%s' should have at least 2 replicas.", [input.metadata.name])
+		}
+
+		warn[{"msg": msg}] {
+			not input.metadata.labels.team
+			msg := sprintf("Deployment '%s' should have the 'team' label.", [input.metadata.name])
+		}`))
+	// test files and other files are ignored
+	writePolicy(t, policyDir, "replicas_test.rego", "invalid")
+	writePolicy(t, policyDir, "README.md", "invalid")
+
+	logger, _ := logtest.NewNullLogger()
+	checker := NewRegoPolicyChecker(logger, config.RegoPolicyFilter{Enabled: true, Path: policyDir})
+
+	unstr := fixUnstructuredDeployment(t, 1)
+	event, err := events.New(metav1.ObjectMeta{Name: "web"}, unstr, config.CreateEvent, "apps/v1/deployments", "sample")
+	require.NoError(t, err)
+
+	// when
+	err = checker.Run(context.Background(), unstr, &event)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Deployment 'web' should have at least 2 replicas."}, event.Warnings)
+	assert.Equal(t, []string{"Deployment 'web' should have the 'team' label."}, event.Recommendations)
+}
+
+func TestRegoPolicyChecker_ReloadsPolicies(t *testing.T) {
+	// given
+	policyDir := t.TempDir()
+	writePolicy(t, policyDir, "policy.rego", heredoc.Doc(`
+		package botkube
+
+		deny[msg] {
+			msg := "first"
+		}`))
+
+	now := time.Now()
+	logger, _ := logtest.NewNullLogger()
+	checker := NewRegoPolicyChecker(logger, config.RegoPolicyFilter{Enabled: true, Path: policyDir})
+	checker.nowFn = func() time.Time { return now }
+
+	unstr := fixUnstructuredDeployment(t, 1)
+	run := func() []string {
+		event, err := events.New(metav1.ObjectMeta{Name: "web"}, unstr, config.UpdateEvent, "apps/v1/deployments", "sample")
+		require.NoError(t, err)
+		require.NoError(t, checker.Run(context.Background(), unstr, &event))
+		return event.Warnings
+	}
+
+	assert.Equal(t, []string{"first"}, run())
+
+	// when
+	writePolicy(t, policyDir, "policy.rego", heredoc.Doc(`
+		package botkube
+
+		deny[msg] {
+			msg := "second"
+		}`))
+
+	// then
+	assert.Equal(t, []string{"first"}, run(), "policies shouldn't be reloaded before the check interval")
+
+	now = now.Add(regoPoliciesCheckInterval)
+	assert.Equal(t, []string{"second"}, run())
+}
+
+func writePolicy(t *testing.T, dir, name, content string) {
+	t.Helper()
+
+	path := filepath.Join(dir, name)
+	require.NoError(t, os.WriteFile(path, []byte(content), 0600))
+	// make sure the modification time differs even on file systems with low time resolution
+	modTime := time.Now().Add(time.Duration(len(content)) * time.Second)
+	require.NoError(t, os.Chtimes(path, modTime, modTime))
+}
+
+func fixUnstructuredDeployment(t *testing.T, replicas int32) *unstructured.Unstructured {
+	t.Helper()
+
+	deploy := &appsv1.Deployment{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Deployment",
+			APIVersion: "apps/v1",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "web",
+		},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: &replicas,
+		},
+	}
+
+	unstrObj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(deploy)
+	require.NoError(t, err)
+	return &unstructured.Unstructured{Object: unstrObj}
+}
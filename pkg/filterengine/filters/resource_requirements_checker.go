@@ -0,0 +1,123 @@
+package filters
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sirupsen/logrus"
+	coreV1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/kubeshop/botkube/pkg/config"
+	"github.com/kubeshop/botkube/pkg/events"
+	"github.com/kubeshop/botkube/pkg/utils"
+)
+
+// maxLimitToRequestRatio defines how many times the container limit can be higher than the request.
+const maxLimitToRequestRatio = 4
+
+// ResourceRequirementsChecker adds recommendations to the event object if containers of the Pod or workload
+// don't specify resource requests and limits or readiness and liveness probes.
+type ResourceRequirementsChecker struct {
+	log logrus.FieldLogger
+}
+
+// NewResourceRequirementsChecker creates a new ResourceRequirementsChecker instance
+func NewResourceRequirementsChecker(log logrus.FieldLogger) *ResourceRequirementsChecker {
+	return &ResourceRequirementsChecker{log: log}
+}
+
+// Run filers and modifies event struct
+func (f *ResourceRequirementsChecker) Run(_ context.Context, object interface{}, event *events.Event) error {
+	if event.Type != config.CreateEvent && event.Type != config.UpdateEvent {
+		return nil
+	}
+	if utils.GetObjectTypeMetaData(object).Kind == "Event" {
+		return nil
+	}
+
+	unstrObj, ok := object.(*unstructured.Unstructured)
+	if !ok {
+		return fmt.Errorf("cannot convert type %T into *unstructured.Unstructured", object)
+	}
+
+	// Pods created by workloads are checked on the workload level
+	if isPodOwnedByWorkload(unstrObj) {
+		return nil
+	}
+
+	podSpec, err := podSpecFromObject(unstrObj)
+	if err != nil {
+		return err
+	}
+	if podSpec == nil {
+		return nil
+	}
+
+	for _, c := range podSpec.Containers {
+		event.Recommendations = append(event.Recommendations, f.checkContainer(c, event.Kind, event.Name)...)
+	}
+
+	f.log.Debug("Resource requirements filter successful!")
+	return nil
+}
+
+// Name returns the filter's name
+func (f *ResourceRequirementsChecker) Name() string {
+	return "ResourceRequirementsChecker"
+}
+
+// Describe describes the filter
+func (f *ResourceRequirementsChecker) Describe() string {
+	return "Checks and adds recommendations if resource requests, limits or probes are missing in the container specs."
+}
+
+func (f *ResourceRequirementsChecker) checkContainer(c coreV1.Container, kind, name string) []string {
+	var recommendations []string
+	missing := func(what string) {
+		recommendations = append(recommendations, fmt.Sprintf("%s of Container '%s' in %s '%s' should be set.", what, c.Name, kind, name))
+	}
+
+	requests, limits := c.Resources.Requests, c.Resources.Limits
+	if _, ok := requests[coreV1.ResourceCPU]; !ok {
+		missing("CPU request")
+	}
+	if _, ok := requests[coreV1.ResourceMemory]; !ok {
+		missing("Memory request")
+	}
+	if _, ok := limits[coreV1.ResourceCPU]; !ok {
+		missing("CPU limit")
+	}
+	if _, ok := limits[coreV1.ResourceMemory]; !ok {
+		missing("Memory limit")
+	}
+	if c.ReadinessProbe == nil {
+		missing("Readiness probe")
+	}
+	if c.LivenessProbe == nil {
+		missing("Liveness probe")
+	}
+
+	for _, res := range []struct {
+		name        coreV1.ResourceName
+		displayName string
+	}{
+		{name: coreV1.ResourceCPU, displayName: "CPU"},
+		{name: coreV1.ResourceMemory, displayName: "Memory"},
+	} {
+		request, hasRequest := requests[res.name]
+		limit, hasLimit := limits[res.name]
+		if !hasRequest || !hasLimit || request.IsZero() {
+			continue
+		}
+
+		if limit.MilliValue() > request.MilliValue()*maxLimitToRequestRatio {
+			recommendations = append(recommendations, fmt.Sprintf(
+				"%s limit (%s) of Container '%s' in %s '%s' is more than %d times higher than the request (%s).",
+				res.displayName, limit.String(), c.Name, kind, name, maxLimitToRequestRatio, request.String(),
+			))
+		}
+	}
+
+	return recommendations
+}
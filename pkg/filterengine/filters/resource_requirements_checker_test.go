@@ -0,0 +1,116 @@
+package filters
+
+import (
+	"context"
+	"testing"
+
+	logtest "github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	coreV1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/kubeshop/botkube/pkg/config"
+	"github.com/kubeshop/botkube/pkg/events"
+)
+
+func TestResourceRequirementsChecker_Run(t *testing.T) {
+	probe := &coreV1.Probe{
+		ProbeHandler: coreV1.ProbeHandler{
+			HTTPGet: &coreV1.HTTPGetAction{Path: "/healthz"},
+		},
+	}
+	isController := true
+
+	tests := []struct {
+		name                    string
+		givenPod                coreV1.Pod
+		expectedRecommendations []string
+	}{
+		{
+			name: "Missing requests, limits and probes",
+			givenPod: coreV1.Pod{
+				ObjectMeta: metav1.ObjectMeta{Name: "web"},
+				Spec: coreV1.PodSpec{
+					Containers: []coreV1.Container{{Name: "nginx"}},
+				},
+			},
+			expectedRecommendations: []string{
+				"CPU request of Container 'nginx' in Pod 'web' should be set.",
+				"Memory request of Container 'nginx' in Pod 'web' should be set.",
+				"CPU limit of Container 'nginx' in Pod 'web' should be set.",
+				"Memory limit of Container 'nginx' in Pod 'web' should be set.",
+				"Readiness probe of Container 'nginx' in Pod 'web' should be set.",
+				"Liveness probe of Container 'nginx' in Pod 'web' should be set.",
+			},
+		},
+		{
+			name: "Limit much higher than request",
+			givenPod: coreV1.Pod{
+				ObjectMeta: metav1.ObjectMeta{Name: "web"},
+				Spec: coreV1.PodSpec{
+					Containers: []coreV1.Container{
+						{
+							Name:           "nginx",
+							ReadinessProbe: probe,
+							LivenessProbe:  probe,
+							Resources: coreV1.ResourceRequirements{
+								Requests: coreV1.ResourceList{
+									coreV1.ResourceCPU:    resource.MustParse("100m"),
+									coreV1.ResourceMemory: resource.MustParse("128Mi"),
+								},
+								Limits: coreV1.ResourceList{
+									coreV1.ResourceCPU:    resource.MustParse("1"),
+									coreV1.ResourceMemory: resource.MustParse("256Mi"),
+								},
+							},
+						},
+					},
+				},
+			},
+			expectedRecommendations: []string{
+				"CPU limit (1) of Container 'nginx' in Pod 'web' is more than 4 times higher than the request (100m).",
+			},
+		},
+		{
+			name: "Pod owned by workload is skipped",
+			givenPod: coreV1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "web-1234",
+					OwnerReferences: []metav1.OwnerReference{
+						{Kind: "ReplicaSet", Name: "web", Controller: &isController},
+					},
+				},
+				Spec: coreV1.PodSpec{
+					Containers: []coreV1.Container{{Name: "nginx"}},
+				},
+			},
+			expectedRecommendations: nil,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			logger, _ := logtest.NewNullLogger()
+			checker := NewResourceRequirementsChecker(logger)
+
+			tc.givenPod.TypeMeta = metav1.TypeMeta{Kind: "Pod", APIVersion: "v1"}
+			unstrObj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&tc.givenPod)
+			require.NoError(t, err)
+			unstr := &unstructured.Unstructured{Object: unstrObj}
+
+			event, err := events.New(tc.givenPod.ObjectMeta, unstr, config.CreateEvent, "v1/pods", "sample")
+			require.NoError(t, err)
+
+			// when
+			err = checker.Run(context.Background(), unstr, &event)
+
+			// then
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedRecommendations, event.Recommendations)
+		})
+	}
+}
@@ -0,0 +1,167 @@
+package filters
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sirupsen/logrus"
+	coreV1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/dynamic"
+	corelisters "k8s.io/client-go/listers/core/v1"
+
+	"github.com/kubeshop/botkube/pkg/config"
+	"github.com/kubeshop/botkube/pkg/events"
+	"github.com/kubeshop/botkube/pkg/utils"
+)
+
+// RoutingRulesMatcher sets the channels of the event based on the first matching routing rule.
+// Events with the channel set by the `botkube.io/channel` annotation are not routed.
+type RoutingRulesMatcher struct {
+	log        logrus.FieldLogger
+	dynamicCli dynamic.Interface
+	mapper     meta.RESTMapper
+	nsLister   corelisters.NamespaceLister
+	rules      []config.RoutingRule
+}
+
+// NewRoutingRulesMatcher creates a new RoutingRulesMatcher instance
+func NewRoutingRulesMatcher(log logrus.FieldLogger, dynamicCli dynamic.Interface, mapper meta.RESTMapper, nsLister corelisters.NamespaceLister, cfg config.Routing) *RoutingRulesMatcher {
+	return &RoutingRulesMatcher{log: log, dynamicCli: dynamicCli, mapper: mapper, nsLister: nsLister, rules: cfg.Rules}
+}
+
+// Run filers and modifies event struct
+func (f *RoutingRulesMatcher) Run(ctx context.Context, object interface{}, event *events.Event) error {
+	if event.Skip || event.Channel != "" {
+		return nil
+	}
+
+	for _, rule := range f.rules {
+		matches, err := f.matches(ctx, rule.Match, object, event)
+		if err != nil {
+			return fmt.Errorf("while matching routing rule %q: %w", rule.Name, err)
+		}
+		if !matches {
+			continue
+		}
+
+		event.RoutedChannels = rule.Channels
+		f.log.Debugf("Routing event using rule %q", rule.Name)
+		return nil
+	}
+
+	f.log.Debug("No routing rule matched. Using default channels")
+	return nil
+}
+
+// Name returns the filter's name
+func (f *RoutingRulesMatcher) Name() string {
+	return "RoutingRulesMatcher"
+}
+
+// Describe describes the filter
+func (f *RoutingRulesMatcher) Describe() string {
+	return "Routes events to channels based on the configured routing rules."
+}
+
+// matches checks the criteria which don't require API calls first.
+func (f *RoutingRulesMatcher) matches(ctx context.Context, match config.RoutingMatch, object interface{}, event *events.Event) (bool, error) {
+	if len(match.Namespaces) > 0 && !matchesAnyNamespace(match.Namespaces, event.Namespace) {
+		return false, nil
+	}
+	if len(match.Kinds) > 0 && !utils.Contains(match.Kinds, event.Kind) {
+		return false, nil
+	}
+	if len(match.Levels) > 0 && !containsLevel(match.Levels, event.Level) {
+		return false, nil
+	}
+	if len(match.Reasons) > 0 && !utils.Contains(match.Reasons, event.Reason) {
+		return false, nil
+	}
+
+	if len(match.NamespaceLabels) > 0 {
+		nsLabels, err := f.namespaceLabels(event.Namespace)
+		if err != nil {
+			return false, err
+		}
+		if !labels.SelectorFromSet(match.NamespaceLabels).Matches(labels.Set(nsLabels)) {
+			return false, nil
+		}
+	}
+
+	if len(match.Labels) > 0 {
+		objLabels, err := f.objectLabels(ctx, object)
+		if err != nil {
+			return false, err
+		}
+		if !labels.SelectorFromSet(match.Labels).Matches(labels.Set(objLabels)) {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+func (f *RoutingRulesMatcher) namespaceLabels(namespace string) (map[string]string, error) {
+	// cluster-scoped objects don't have namespace labels
+	if f.nsLister == nil || namespace == "" {
+		return nil, nil
+	}
+
+	ns, err := f.nsLister.Get(namespace)
+	if apierrors.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("while getting Namespace %q: %w", namespace, err)
+	}
+	return ns.Labels, nil
+}
+
+// objectLabels returns labels of a given object. For Kubernetes Events, labels of the involved object are returned.
+func (f *RoutingRulesMatcher) objectLabels(ctx context.Context, object interface{}) (map[string]string, error) {
+	unstrObj, ok := object.(*unstructured.Unstructured)
+	if !ok {
+		return nil, fmt.Errorf("cannot convert type %T into *unstructured.Unstructured", object)
+	}
+	if unstrObj.GetKind() != "Event" {
+		return unstrObj.GetLabels(), nil
+	}
+
+	var eventObj coreV1.Event
+	if err := utils.TransformIntoTypedObject(unstrObj, &eventObj); err != nil {
+		return nil, fmt.Errorf("while transforming object type %T into type: %T: %w", object, eventObj, err)
+	}
+
+	gvr, err := utils.GetResourceFromKind(f.mapper, eventObj.InvolvedObject.GroupVersionKind())
+	if err != nil {
+		return nil, err
+	}
+	involved, err := f.dynamicCli.Resource(gvr).Namespace(eventObj.InvolvedObject.Namespace).Get(ctx, eventObj.InvolvedObject.Name, metaV1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("while getting involved object: %w", err)
+	}
+	return involved.GetLabels(), nil
+}
+
+func matchesAnyNamespace(patterns []string, namespace string) bool {
+	for _, pattern := range patterns {
+		if utils.NamespaceMatches(pattern, namespace) {
+			return true
+		}
+	}
+	return false
+}
+
+func containsLevel(levels []config.Level, level config.Level) bool {
+	for _, l := range levels {
+		if l == level {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,123 @@
+package filters
+
+import (
+	"context"
+	"testing"
+
+	logtest "github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	coreV1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/kubeshop/botkube/pkg/config"
+	"github.com/kubeshop/botkube/pkg/events"
+)
+
+func TestRoutingRulesMatcher_Run(t *testing.T) {
+	rules := config.Routing{
+		Rules: []config.RoutingRule{
+			{
+				Name: "payments critical",
+				Match: config.RoutingMatch{
+					Namespaces: []string{"payments-*"},
+					Levels:     []config.Level{config.Critical},
+				},
+				Channels: config.RoutingChannels{Slack: []string{"payments-oncall"}},
+			},
+			{
+				Name: "team A",
+				Match: config.RoutingMatch{
+					NamespaceLabels: map[string]string{"team": "a"},
+				},
+				Channels: config.RoutingChannels{Slack: []string{"team-a"}, Discord: []string{"1234"}},
+			},
+			{
+				Name: "databases",
+				Match: config.RoutingMatch{
+					Kinds:  []string{"Pod"},
+					Labels: map[string]string{"tier": "db"},
+				},
+				Channels: config.RoutingChannels{Mattermost: []string{"dba"}},
+			},
+		},
+	}
+	teamANamespace := &coreV1.Namespace{
+		TypeMeta:   metav1.TypeMeta{Kind: "Namespace", APIVersion: "v1"},
+		ObjectMeta: metav1.ObjectMeta{Name: "team-a", Labels: map[string]string{"team": "a"}},
+	}
+	otherNamespace := &coreV1.Namespace{
+		TypeMeta:   metav1.TypeMeta{Kind: "Namespace", APIVersion: "v1"},
+		ObjectMeta: metav1.ObjectMeta{Name: "default"},
+	}
+
+	tests := []struct {
+		name             string
+		givenNamespace   string
+		givenLevel       config.Level
+		givenLabels      map[string]string
+		givenChannel     string
+		expectedChannels config.RoutingChannels
+	}{
+		{
+			name:             "First matching rule wins",
+			givenNamespace:   "payments-eu",
+			givenLevel:       config.Critical,
+			expectedChannels: config.RoutingChannels{Slack: []string{"payments-oncall"}},
+		},
+		{
+			name:             "Namespace labels",
+			givenNamespace:   "team-a",
+			givenLevel:       config.Error,
+			givenLabels:      map[string]string{"tier": "db"},
+			expectedChannels: config.RoutingChannels{Slack: []string{"team-a"}, Discord: []string{"1234"}},
+		},
+		{
+			name:             "Object labels",
+			givenNamespace:   "default",
+			givenLevel:       config.Error,
+			givenLabels:      map[string]string{"tier": "db"},
+			expectedChannels: config.RoutingChannels{Mattermost: []string{"dba"}},
+		},
+		{
+			name:           "No matching rule",
+			givenNamespace: "default",
+			givenLevel:     config.Error,
+		},
+		{
+			name:           "Channel set by annotation",
+			givenNamespace: "payments-eu",
+			givenLevel:     config.Critical,
+			givenChannel:   "from-annotation",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			logger, _ := logtest.NewNullLogger()
+			matcher := NewRoutingRulesMatcher(logger, nil, nil, fixNamespaceLister(t, teamANamespace, otherNamespace), rules)
+
+			pod := &coreV1.Pod{
+				TypeMeta:   metav1.TypeMeta{Kind: "Pod", APIVersion: "v1"},
+				ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: tc.givenNamespace, Labels: tc.givenLabels},
+			}
+			unstrObj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(pod)
+			require.NoError(t, err)
+			unstr := &unstructured.Unstructured{Object: unstrObj}
+
+			event, err := events.New(pod.ObjectMeta, unstr, config.CreateEvent, "v1/pods", "sample")
+			require.NoError(t, err)
+			event.Level = tc.givenLevel
+			event.Channel = tc.givenChannel
+
+			// when
+			err = matcher.Run(context.Background(), unstr, &event)
+
+			// then
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedChannels, event.RoutedChannels)
+		})
+	}
+}
@@ -0,0 +1,191 @@
+package filters
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	coreV1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/dynamic"
+
+	"github.com/kubeshop/botkube/pkg/config"
+	"github.com/kubeshop/botkube/pkg/events"
+	"github.com/kubeshop/botkube/pkg/utils"
+)
+
+const defaultEndpointsGracePeriod = 2 * time.Minute
+
+var (
+	podGVR = schema.GroupVersionResource{
+		Version:  "v1",
+		Resource: "pods",
+	}
+	endpointsGVR = schema.GroupVersionResource{
+		Version:  "v1",
+		Resource: "endpoints",
+	}
+)
+
+// ServiceValidator checks if the Service selector and target ports match existing Pods
+// and if the Service has any endpoints, and adds warnings to event struct accordingly
+type ServiceValidator struct {
+	log        logrus.FieldLogger
+	dynamicCli dynamic.Interface
+	cfg        config.ServiceValidatorFilter
+	nowFn      func() time.Time
+}
+
+// NewServiceValidator creates a new ServiceValidator instance
+func NewServiceValidator(log logrus.FieldLogger, dynamicCli dynamic.Interface, cfg config.ServiceValidatorFilter) *ServiceValidator {
+	if cfg.EndpointsGracePeriod == 0 {
+		cfg.EndpointsGracePeriod = defaultEndpointsGracePeriod
+	}
+	return &ServiceValidator{log: log, dynamicCli: dynamicCli, cfg: cfg, nowFn: time.Now}
+}
+
+// Run filers and modifies event struct
+func (f *ServiceValidator) Run(ctx context.Context, object interface{}, event *events.Event) error {
+	if event.Kind != "Service" || utils.GetObjectTypeMetaData(object).Kind == "Event" {
+		return nil
+	}
+	// Warning events are sent by the delayed recheck after the endpoints grace period
+	if event.Type != config.CreateEvent && event.Type != config.UpdateEvent && event.Type != config.WarningEvent {
+		return nil
+	}
+
+	var svc coreV1.Service
+	err := utils.TransformIntoTypedObject(object.(*unstructured.Unstructured), &svc)
+	if err != nil {
+		return fmt.Errorf("while transforming object type %T into type: %T: %w", object, svc, err)
+	}
+
+	// Services without selector have manually managed endpoints
+	if svc.Spec.Type == coreV1.ServiceTypeExternalName || len(svc.Spec.Selector) == 0 {
+		return nil
+	}
+
+	if event.Type != config.WarningEvent {
+		pods, err := f.selectedPods(ctx, svc)
+		if err != nil {
+			return err
+		}
+		if len(pods) == 0 {
+			event.Warnings = append(event.Warnings, fmt.Sprintf("Selector of Service '%s' does not match any Pod", svc.Name))
+			return nil
+		}
+
+		for _, port := range svc.Spec.Ports {
+			targetPort := port.TargetPort
+			if targetPort.Type == intstr.Int && targetPort.IntVal == 0 {
+				// target port defaults to the port value
+				targetPort = intstr.FromInt(int(port.Port))
+			}
+			if !podsExposePort(pods, targetPort, port.Protocol) {
+				event.Warnings = append(event.Warnings, fmt.Sprintf("Target port '%s' of Service '%s' does not match any container port of the selected Pods", targetPort.String(), svc.Name))
+			}
+		}
+	}
+
+	age := f.nowFn().Sub(svc.CreationTimestamp.Time)
+	if age < f.cfg.EndpointsGracePeriod {
+		// Endpoints are checked again once the grace period ends
+		event.RecheckAfter = f.cfg.EndpointsGracePeriod - age
+		return nil
+	}
+
+	hasEndpoints, err := f.hasReadyEndpoints(ctx, svc)
+	if err != nil {
+		return err
+	}
+	if !hasEndpoints {
+		event.Warnings = append(event.Warnings, fmt.Sprintf("Service '%s' does not have any ready endpoints", svc.Name))
+	}
+
+	f.log.Debug("Service Validator filter successful!")
+	return nil
+}
+
+// Name returns the filter's name
+func (f *ServiceValidator) Name() string {
+	return "ServiceValidator"
+}
+
+// Describe describes the filter
+func (f *ServiceValidator) Describe() string {
+	return "Checks if service selectors and target ports match existing pods and if services have ready endpoints."
+}
+
+func (f *ServiceValidator) selectedPods(ctx context.Context, svc coreV1.Service) ([]coreV1.Pod, error) {
+	list, err := f.dynamicCli.Resource(podGVR).Namespace(svc.Namespace).List(ctx, metaV1.ListOptions{
+		LabelSelector: labels.SelectorFromSet(svc.Spec.Selector).String(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("while listing Pods selected by Service %q: %w", svc.Name, err)
+	}
+
+	var pods []coreV1.Pod
+	for i := range list.Items {
+		var pod coreV1.Pod
+		if err := utils.TransformIntoTypedObject(&list.Items[i], &pod); err != nil {
+			return nil, fmt.Errorf("while transforming object type %T into type: %T: %w", list.Items[i], pod, err)
+		}
+		pods = append(pods, pod)
+	}
+	return pods, nil
+}
+
+func (f *ServiceValidator) hasReadyEndpoints(ctx context.Context, svc coreV1.Service) (bool, error) {
+	unstrEndpoints, err := f.dynamicCli.Resource(endpointsGVR).Namespace(svc.Namespace).Get(ctx, svc.Name, metaV1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("while getting Endpoints for Service %q: %w", svc.Name, err)
+	}
+
+	var endpoints coreV1.Endpoints
+	if err := utils.TransformIntoTypedObject(unstrEndpoints, &endpoints); err != nil {
+		return false, fmt.Errorf("while transforming object type %T into type: %T: %w", unstrEndpoints, endpoints, err)
+	}
+	for _, subset := range endpoints.Subsets {
+		if len(subset.Addresses) > 0 {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// podsExposePort returns true if any container of the Pods exposes a given port number or named port.
+func podsExposePort(pods []coreV1.Pod, targetPort intstr.IntOrString, protocol coreV1.Protocol) bool {
+	if protocol == "" {
+		protocol = coreV1.ProtocolTCP
+	}
+	for _, pod := range pods {
+		for _, c := range pod.Spec.Containers {
+			for _, p := range c.Ports {
+				portProtocol := p.Protocol
+				if portProtocol == "" {
+					portProtocol = coreV1.ProtocolTCP
+				}
+				if portProtocol != protocol {
+					continue
+				}
+
+				if targetPort.Type == intstr.String && p.Name == targetPort.StrVal {
+					return true
+				}
+				if targetPort.Type == intstr.Int && p.ContainerPort == targetPort.IntVal {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
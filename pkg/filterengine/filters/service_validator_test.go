@@ -0,0 +1,152 @@
+package filters
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	logtest "github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	coreV1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/dynamic/fake"
+
+	"github.com/kubeshop/botkube/pkg/config"
+	"github.com/kubeshop/botkube/pkg/events"
+)
+
+func TestServiceValidator_Run(t *testing.T) {
+	now := time.Date(2022, 7, 1, 12, 0, 0, 0, time.UTC)
+
+	pod := &coreV1.Pod{
+		TypeMeta: metav1.TypeMeta{Kind: "Pod", APIVersion: "v1"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "web-1234",
+			Namespace: "default",
+			Labels:    map[string]string{"app": "web"},
+		},
+		Spec: coreV1.PodSpec{
+			Containers: []coreV1.Container{
+				{
+					Name:  "nginx",
+					Ports: []coreV1.ContainerPort{{Name: "http", ContainerPort: 8080}},
+				},
+			},
+		},
+	}
+	readyEndpoints := &coreV1.Endpoints{
+		TypeMeta:   metav1.TypeMeta{Kind: "Endpoints", APIVersion: "v1"},
+		ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default"},
+		Subsets: []coreV1.EndpointSubset{
+			{Addresses: []coreV1.EndpointAddress{{IP: "10.0.0.1"}}},
+		},
+	}
+
+	tests := []struct {
+		name             string
+		givenObjects     []runtime.Object
+		givenSelector    map[string]string
+		givenTargetPort  intstr.IntOrString
+		givenAge         time.Duration
+		givenEventType   config.EventType
+		expectedWarnings []string
+		expectedRecheck  time.Duration
+	}{
+		{
+			name:            "Valid Service",
+			givenObjects:    []runtime.Object{pod, readyEndpoints},
+			givenSelector:   map[string]string{"app": "web"},
+			givenTargetPort: intstr.FromString("http"),
+			givenAge:        time.Hour,
+		},
+		{
+			name:            "Selector without Pods",
+			givenObjects:    []runtime.Object{pod},
+			givenSelector:   map[string]string{"app": "api"},
+			givenTargetPort: intstr.FromInt(8080),
+			givenAge:        time.Hour,
+			expectedWarnings: []string{
+				"Selector of Service 'web' does not match any Pod",
+			},
+		},
+		{
+			name:            "Invalid target port and no endpoints",
+			givenObjects:    []runtime.Object{pod},
+			givenSelector:   map[string]string{"app": "web"},
+			givenTargetPort: intstr.FromInt(9090),
+			givenAge:        time.Hour,
+			expectedWarnings: []string{
+				"Target port '9090' of Service 'web' does not match any container port of the selected Pods",
+				"Service 'web' does not have any ready endpoints",
+			},
+		},
+		{
+			name:            "No endpoints within grace period",
+			givenObjects:    []runtime.Object{pod},
+			givenSelector:   map[string]string{"app": "web"},
+			givenTargetPort: intstr.FromInt(8080),
+			givenAge:        time.Second,
+			expectedRecheck: time.Minute - time.Second,
+		},
+		{
+			name:            "Recheck after grace period",
+			givenObjects:    []runtime.Object{pod},
+			givenSelector:   map[string]string{"app": "api"},
+			givenTargetPort: intstr.FromInt(9090),
+			givenAge:        time.Minute,
+			givenEventType:  config.WarningEvent,
+			expectedWarnings: []string{
+				"Service 'web' does not have any ready endpoints",
+			},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			scheme := runtime.NewScheme()
+			require.NoError(t, coreV1.AddToScheme(scheme))
+			dynamicCli := fake.NewSimpleDynamicClientWithCustomListKinds(scheme, map[schema.GroupVersionResource]string{
+				podGVR: "PodList",
+			}, tc.givenObjects...)
+			logger, _ := logtest.NewNullLogger()
+			validator := NewServiceValidator(logger, dynamicCli, config.ServiceValidatorFilter{EndpointsGracePeriod: time.Minute})
+			validator.nowFn = func() time.Time { return now }
+
+			svc := &coreV1.Service{
+				TypeMeta: metav1.TypeMeta{Kind: "Service", APIVersion: "v1"},
+				ObjectMeta: metav1.ObjectMeta{
+					Name:              "web",
+					Namespace:         "default",
+					CreationTimestamp: metav1.NewTime(now.Add(-tc.givenAge)),
+				},
+				Spec: coreV1.ServiceSpec{
+					Selector: tc.givenSelector,
+					Ports:    []coreV1.ServicePort{{Port: 80, TargetPort: tc.givenTargetPort}},
+				},
+			}
+			unstrObj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(svc)
+			require.NoError(t, err)
+			unstr := &unstructured.Unstructured{Object: unstrObj}
+
+			eventType := tc.givenEventType
+			if eventType == "" {
+				eventType = config.UpdateEvent
+			}
+			event, err := events.New(svc.ObjectMeta, unstr, eventType, "v1/services", "sample")
+			require.NoError(t, err)
+
+			// when
+			err = validator.Run(context.Background(), unstr, &event)
+
+			// then
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedWarnings, event.Warnings)
+			assert.Equal(t, tc.expectedRecheck, event.RecheckAfter)
+		})
+	}
+}
@@ -5,8 +5,10 @@ import (
 	"fmt"
 	"strings"
 
+	appsV1 "k8s.io/api/apps/v1"
 	coreV1 "k8s.io/api/core/v1"
 	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/client-go/dynamic"
 
@@ -65,3 +67,52 @@ func FindNamespaceFromService(service string) string {
 	}
 	return "default"
 }
+
+// podSpecFromObject returns the Pod spec for Pods and Pod templates of the workloads.
+// It returns nil if a given kind doesn't contain Pod spec.
+func podSpecFromObject(obj *unstructured.Unstructured) (*coreV1.PodSpec, error) {
+	var spec coreV1.PodSpec
+	var err error
+	switch obj.GetKind() {
+	case "Pod":
+		var pod coreV1.Pod
+		err = utils.TransformIntoTypedObject(obj, &pod)
+		spec = pod.Spec
+	case "Deployment":
+		var deploy appsV1.Deployment
+		err = utils.TransformIntoTypedObject(obj, &deploy)
+		spec = deploy.Spec.Template.Spec
+	case "StatefulSet":
+		var sts appsV1.StatefulSet
+		err = utils.TransformIntoTypedObject(obj, &sts)
+		spec = sts.Spec.Template.Spec
+	case "DaemonSet":
+		var ds appsV1.DaemonSet
+		err = utils.TransformIntoTypedObject(obj, &ds)
+		spec = ds.Spec.Template.Spec
+	default:
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("while transforming object type %T into type %s: %w", obj, obj.GetKind(), err)
+	}
+
+	return &spec, nil
+}
+
+// isPodOwnedByWorkload returns true if the Pod is managed by the ReplicaSet, StatefulSet or DaemonSet controller.
+func isPodOwnedByWorkload(obj *unstructured.Unstructured) bool {
+	if obj.GetKind() != "Pod" {
+		return false
+	}
+	for _, owner := range obj.GetOwnerReferences() {
+		if owner.Controller == nil || !*owner.Controller {
+			continue
+		}
+		switch owner.Kind {
+		case "ReplicaSet", "StatefulSet", "DaemonSet":
+			return true
+		}
+	}
+	return false
+}
@@ -4,6 +4,8 @@ import (
 	"github.com/sirupsen/logrus"
 	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+	corelisters "k8s.io/client-go/listers/core/v1"
 
 	"github.com/kubeshop/botkube/pkg/config"
 	"github.com/kubeshop/botkube/pkg/filterengine/filters"
@@ -15,18 +17,57 @@ const (
 )
 
 // WithAllFilters returns new DefaultFilterEngine instance with all filters registered.
-func WithAllFilters(logger *logrus.Logger, dynamicCli dynamic.Interface, mapper meta.RESTMapper, conf *config.Config) *DefaultFilterEngine {
-	res := conf.Sources.GetFirst().Kubernetes.Resources
+func WithAllFilters(logger *logrus.Logger, dynamicCli dynamic.Interface, k8sCli kubernetes.Interface, mapper meta.RESTMapper, nsLister corelisters.NamespaceLister, conf *config.Config) *DefaultFilterEngine {
+	sources := conf.Sources.GetFirst()
+	res := sources.Kubernetes.Resources
+
+	// Namespace annotations are ignored if not enabled
+	annotationsNsLister := nsLister
+	if !sources.Filters.NamespaceAnnotations.Enabled {
+		annotationsNsLister = nil
+	}
 
 	filterEngine := New(logger.WithField(componentLogFieldKey, "Filter Engine"))
 	filterEngine.Register([]Filter{
 		filters.NewImageTagChecker(logger.WithField(filterLogFieldKey, "Image Tag Checker")),
 		filters.NewIngressValidator(logger.WithField(filterLogFieldKey, "Ingress Validator"), dynamicCli),
-		filters.NewObjectAnnotationChecker(logger.WithField(filterLogFieldKey, "Object Annotation Checker"), dynamicCli, mapper),
+		filters.NewObjectAnnotationChecker(logger.WithField(filterLogFieldKey, "Object Annotation Checker"), dynamicCli, mapper, annotationsNsLister),
 		filters.NewPodLabelChecker(logger.WithField(filterLogFieldKey, "Pod Label Checker"), dynamicCli, mapper),
 		filters.NewNamespaceChecker(logger.WithField(filterLogFieldKey, "Namespace Checker"), res),
 		filters.NewNodeEventsChecker(logger.WithField(filterLogFieldKey, "Node Events Checker")),
+		filters.NewResourceRequirementsChecker(logger.WithField(filterLogFieldKey, "Resource Requirements Checker")),
+		filters.NewDeprecatedAPIChecker(logger.WithField(filterLogFieldKey, "Deprecated API Checker"), k8sCli.Discovery()),
 	}...)
 
+	if sources.Filters.RegoPolicy.Enabled {
+		filterEngine.Register(filters.NewRegoPolicyChecker(logger.WithField(filterLogFieldKey, "Rego Policy Checker"), sources.Filters.RegoPolicy))
+	}
+	if sources.Filters.ServiceValidator.Enabled {
+		filterEngine.Register(filters.NewServiceValidator(logger.WithField(filterLogFieldKey, "Service Validator"), dynamicCli, sources.Filters.ServiceValidator))
+	}
+	if sources.Filters.PodSecurity.Enabled {
+		filterEngine.Register(filters.NewPodSecurityChecker(logger.WithField(filterLogFieldKey, "Pod Security Checker"), sources.Filters.PodSecurity))
+	}
+	if len(sources.Routing.Rules) > 0 {
+		filterEngine.Register(filters.NewRoutingRulesMatcher(logger.WithField(filterLogFieldKey, "Routing Rules Matcher"), dynamicCli, mapper, nsLister, sources.Routing))
+	}
+	if sources.Filters.PodErrorEnrichment.Enabled {
+		filterEngine.Register(filters.NewPodErrorEnricher(logger.WithField(filterLogFieldKey, "Pod Error Enricher"), k8sCli, sources.Filters.PodErrorEnrichment))
+	}
+
 	return filterEngine
 }
+
+// NamespaceListerRequired returns true if the configured filters read the Namespace annotations or labels.
+func NamespaceListerRequired(conf *config.Config) bool {
+	sources := conf.Sources.GetFirst()
+	if sources.Filters.NamespaceAnnotations.Enabled {
+		return true
+	}
+	for _, rule := range sources.Routing.Rules {
+		if len(rule.Match.NamespaceLabels) > 0 {
+			return true
+		}
+	}
+	return false
+}
@@ -3,12 +3,15 @@ package notifier
 import (
 	"context"
 	"fmt"
+	"sort"
+	"strings"
 
 	"github.com/bwmarrin/discordgo"
 	"github.com/sirupsen/logrus"
 
 	"github.com/kubeshop/botkube/pkg/config"
 	"github.com/kubeshop/botkube/pkg/events"
+	"github.com/kubeshop/botkube/pkg/multierror"
 )
 
 // customTimeFormat holds custom time format string
@@ -27,9 +30,12 @@ type Discord struct {
 	log logrus.FieldLogger
 	api *discordgo.Session
 
-	Token        string
-	ChannelID    string
+	Token string
+	// ChannelIDs contains IDs of the configured channels which bind the sources
+	ChannelIDs   []string
 	Notification config.Notification
+
+	threads *incidentThreads
 }
 
 // NewDiscord returns new Discord object
@@ -39,11 +45,22 @@ func NewDiscord(log logrus.FieldLogger, c config.Discord) (*Discord, error) {
 		return nil, fmt.Errorf("while creating Discord session: %w", err)
 	}
 
+	var channelIDs []string
+	for _, channel := range c.Channels {
+		if len(channel.Bindings.Sources) == 0 {
+			// channels which don't bind any source don't receive notifications
+			continue
+		}
+		channelIDs = append(channelIDs, channel.ID)
+	}
+	sort.Strings(channelIDs)
+
 	return &Discord{
 		log:          log,
 		api:          api,
-		ChannelID:    c.Channels.GetFirst().ID,
+		ChannelIDs:   channelIDs,
 		Notification: c.Notification,
+		threads:      newIncidentThreads(),
 	}, nil
 }
 
@@ -52,26 +69,69 @@ func NewDiscord(log logrus.FieldLogger, c config.Discord) (*Discord, error) {
 func (d *Discord) SendEvent(_ context.Context, event events.Event) (err error) {
 	d.log.Debugf(">> Sending to discord: %+v", event)
 
-	messageSend := formatDiscordMessage(event, d.Notification)
+	if event.Type == config.ResolvedEvent {
+		if refs := d.threads.Pop(event.IncidentKey); len(refs) > 0 {
+			return d.sendEventAsReplies(event, refs)
+		}
+	}
+
+	channelIDs := event.RoutedChannels.Discord
+	if len(channelIDs) == 0 {
+		// events without the channel are sent to all channels which bind the sources
+		channelIDs = d.ChannelIDs
+	}
+
+	var errs error
+	for _, channelID := range channelIDs {
+		// files are read while sending, so the message is formatted for every channel
+		messageSend := formatDiscordMessage(event, d.Notification)
+		msg, err := d.api.ChannelMessageSendComplex(channelID, &messageSend)
+		if err != nil {
+			errs = multierror.Append(errs, fmt.Errorf("while sending Discord message to channel %q: %w", channelID, err))
+			continue
+		}
 
-	if _, err := d.api.ChannelMessageSendComplex(d.ChannelID, &messageSend); err != nil {
-		return fmt.Errorf("while sending Discord message to channel %q: %w", d.ChannelID, err)
+		d.log.Debugf("Event successfully sent to channel %s", channelID)
+		if event.Type != config.ResolvedEvent {
+			d.threads.Add(event.IncidentKey, msg.ChannelID, msg.ID)
+		}
 	}
+	return errs
+}
 
-	d.log.Debugf("Event successfully sent to channel %s", d.ChannelID)
-	return nil
+// sendEventAsReplies sends the event as a reply to the messages about the incident.
+func (d *Discord) sendEventAsReplies(event events.Event, refs []threadRef) error {
+	var errs error
+	for _, ref := range refs {
+		messageSend := formatDiscordMessage(event, d.Notification)
+		messageSend.Reference = &discordgo.MessageReference{
+			MessageID: ref.MessageID,
+			ChannelID: ref.ChannelID,
+		}
+		if _, err := d.api.ChannelMessageSendComplex(ref.ChannelID, &messageSend); err != nil {
+			errs = multierror.Append(errs, fmt.Errorf("while replying to Discord message %q in channel %q: %w", ref.MessageID, ref.ChannelID, err))
+			continue
+		}
+
+		d.log.Debugf("Event successfully sent as a reply to message %q in channel %q", ref.MessageID, ref.ChannelID)
+	}
+	return errs
 }
 
-// SendMessage sends message to Discord Channel
+// SendMessage sends message to the configured Discord Channels
 // Context is not supported by client: See https://github.com/bwmarrin/discordgo/issues/752
 func (d *Discord) SendMessage(_ context.Context, msg string) error {
 	d.log.Debugf(">> Sending to discord: %+v", msg)
 
-	if _, err := d.api.ChannelMessageSend(d.ChannelID, msg); err != nil {
-		return fmt.Errorf("while sending Discord message to channel %q: %w", d.ChannelID, err)
+	var errs error
+	for _, channelID := range d.ChannelIDs {
+		if _, err := d.api.ChannelMessageSend(channelID, msg); err != nil {
+			errs = multierror.Append(errs, fmt.Errorf("while sending Discord message to channel %q: %w", channelID, err))
+			continue
+		}
+		d.log.Debugf("Event successfully sent to Discord channel %s", channelID)
 	}
-	d.log.Debugf("Event successfully sent to Discord %v", msg)
-	return nil
+	return errs
 }
 
 // IntegrationName describes the notifier integration name.
@@ -83,6 +143,22 @@ func (d *Discord) IntegrationName() config.CommPlatformIntegration {
 func (d *Discord) Type() config.IntegrationType {
 	return config.BotIntegrationType
 }
+
+// discordAckButtonID returns the custom ID of the button which acknowledges the event with a given ID.
+// The custom ID is the command executed by the bot when the button is pressed.
+func discordAckButtonID(ackID string) string {
+	return fmt.Sprintf("ack %s", ackID)
+}
+
+// discordMentions formats Discord role IDs as mentions.
+func discordMentions(roleIDs []string) string {
+	var mentions []string
+	for _, id := range roleIDs {
+		mentions = append(mentions, fmt.Sprintf("<@&%s>", id))
+	}
+	return strings.Join(mentions, " ")
+}
+
 func formatDiscordMessage(event events.Event, notification config.Notification) discordgo.MessageSend {
 	var messageEmbed discordgo.MessageEmbed
 
@@ -105,9 +181,34 @@ func formatDiscordMessage(event events.Event, notification config.Notification)
 
 	messageEmbed.Color = embedColor[event.Level]
 
-	return discordgo.MessageSend{
-		Embed: &messageEmbed,
+	messageSend := discordgo.MessageSend{
+		Content: messageText(discordMentions(event.Mentions[config.DiscordCommPlatformIntegration]), ackHint(event)),
+		Embed:   &messageEmbed,
+	}
+
+	if event.AckID != "" {
+		messageSend.Components = []discordgo.MessageComponent{
+			discordgo.ActionsRow{
+				Components: []discordgo.MessageComponent{
+					discordgo.Button{
+						Label:    "Acknowledge",
+						Style:    discordgo.PrimaryButton,
+						CustomID: discordAckButtonID(event.AckID),
+					},
+				},
+			},
+		}
+	}
+
+	if event.Manifest != "" {
+		messageSend.Files = append(messageSend.Files, &discordgo.File{
+			Name:        manifestFileName(event),
+			ContentType: "text/yaml",
+			Reader:      strings.NewReader(event.Manifest),
+		})
 	}
+
+	return messageSend
 }
 
 func discordLongNotification(event events.Event) discordgo.MessageEmbed {
@@ -138,6 +239,14 @@ func discordLongNotification(event events.Event) discordgo.MessageEmbed {
 		})
 	}
 
+	if event.Owner != nil {
+		messageEmbed.Fields = append(messageEmbed.Fields, &discordgo.MessageEmbedField{
+			Name:   "Owner",
+			Value:  event.Owner.String(),
+			Inline: true,
+		})
+	}
+
 	if event.Reason != "" {
 		messageEmbed.Fields = append(messageEmbed.Fields, &discordgo.MessageEmbedField{
 			Name:   "Reason",
@@ -18,6 +18,7 @@ import (
 	"github.com/sha1sum/aws_signing_client"
 	"github.com/sirupsen/logrus"
 
+	"github.com/kubeshop/botkube/pkg/audit"
 	"github.com/kubeshop/botkube/pkg/config"
 	"github.com/kubeshop/botkube/pkg/events"
 )
@@ -140,9 +141,9 @@ type index struct {
 	Replicas int `json:"number_of_replicas"`
 }
 
-func (e *Elasticsearch) flushIndex(ctx context.Context, event interface{}) error {
+func (e *Elasticsearch) flushIndex(ctx context.Context, indexPrefix string, event interface{}) error {
 	// Construct the ELS Index Name with timestamp suffix
-	indexName := e.Index + "-" + time.Now().Format(indexSuffixFormat)
+	indexName := indexPrefix + "-" + time.Now().Format(indexSuffixFormat)
 	// Create index if not exists
 	exists, err := e.ELSClient.IndexExists(indexName).Do(ctx)
 	if err != nil {
@@ -182,13 +183,27 @@ func (e *Elasticsearch) SendEvent(ctx context.Context, event events.Event) (err
 	e.log.Debugf(">> Sending to Elasticsearch: %+v", event)
 
 	// Create index if not exists
-	if err := e.flushIndex(ctx, event); err != nil {
+	if err := e.flushIndex(ctx, e.Index, event); err != nil {
 		return fmt.Errorf("while sending event to Elasticsearch: %w", err)
 	}
 
 	return nil
 }
 
+// elasticsearchAuditSink stores the audit records in a separate Elasticsearch index.
+type elasticsearchAuditSink struct {
+	els   *Elasticsearch
+	index string
+}
+
+// SendAuditEvent sends the audit record of the executed command to Elasticsearch
+func (s *elasticsearchAuditSink) SendAuditEvent(ctx context.Context, event audit.Event) error {
+	if err := s.els.flushIndex(ctx, s.index, event); err != nil {
+		return fmt.Errorf("while sending audit record to Elasticsearch: %w", err)
+	}
+	return nil
+}
+
 // SendMessage is no-op
 func (e *Elasticsearch) SendMessage(_ context.Context, _ string) error {
 	return nil
@@ -0,0 +1,70 @@
+package notifier
+
+import (
+	"sync"
+	"time"
+)
+
+// incidentThreadTTL is the time after which the messages of unresolved incidents are forgotten.
+const incidentThreadTTL = 72 * time.Hour
+
+// threadRef references the first message about the incident sent to a given channel.
+type threadRef struct {
+	ChannelID string
+	MessageID string
+	sentAt    time.Time
+}
+
+// incidentThreads stores the messages about the open incidents, so the resolved notifications
+// can be posted in their threads.
+type incidentThreads struct {
+	nowFn func() time.Time
+
+	mu   sync.Mutex
+	refs map[string][]threadRef
+}
+
+func newIncidentThreads() *incidentThreads {
+	return &incidentThreads{
+		nowFn: time.Now,
+		refs:  map[string][]threadRef{},
+	}
+}
+
+// Add stores the message about the incident. Only the first message per channel is stored.
+func (t *incidentThreads) Add(incidentKey, channelID, messageID string) {
+	if incidentKey == "" || messageID == "" {
+		return
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	now := t.nowFn()
+	for key, refs := range t.refs {
+		if len(refs) > 0 && now.Sub(refs[0].sentAt) > incidentThreadTTL {
+			delete(t.refs, key)
+		}
+	}
+
+	for _, ref := range t.refs[incidentKey] {
+		if ref.ChannelID == channelID {
+			return
+		}
+	}
+	t.refs[incidentKey] = append(t.refs[incidentKey], threadRef{ChannelID: channelID, MessageID: messageID, sentAt: now})
+}
+
+// Pop returns and forgets the messages about the incident.
+func (t *incidentThreads) Pop(incidentKey string) []threadRef {
+	if incidentKey == "" {
+		return nil
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	refs := t.refs[incidentKey]
+	delete(t.refs, incidentKey)
+	return refs
+}
@@ -0,0 +1,37 @@
+package notifier
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIncidentThreads(t *testing.T) {
+	// given
+	now := time.Date(2022, 7, 1, 12, 0, 0, 0, time.UTC)
+	threads := newIncidentThreads()
+	threads.nowFn = func() time.Time { return now }
+
+	// when
+	threads.Add("pod/default/web/BackOff", "C1", "1.1")
+	threads.Add("pod/default/web/BackOff", "C1", "1.2")
+	threads.Add("pod/default/web/BackOff", "C2", "2.1")
+	threads.Add("pod/default/api/BackOff", "C1", "3.1")
+	threads.Add("", "C1", "4.1")
+
+	// then
+	refs := threads.Pop("pod/default/web/BackOff")
+	assert.Len(t, refs, 2, "only the first message per channel should be stored")
+	assert.Equal(t, "1.1", refs[0].MessageID)
+	assert.Equal(t, "2.1", refs[1].MessageID)
+	assert.Empty(t, threads.Pop("pod/default/web/BackOff"), "messages should be forgotten")
+
+	// when
+	now = now.Add(incidentThreadTTL + time.Minute)
+	threads.Add("pod/default/db/BackOff", "C1", "5.1")
+
+	// then
+	assert.Empty(t, threads.Pop("pod/default/api/BackOff"), "outdated messages should be forgotten")
+	assert.Len(t, threads.Pop("pod/default/db/BackOff"), 1)
+}
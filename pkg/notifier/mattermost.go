@@ -4,7 +4,10 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"sort"
 	"strconv"
+	"strings"
+	"sync"
 
 	"github.com/mattermost/mattermost-server/v5/model"
 	"github.com/sirupsen/logrus"
@@ -12,15 +15,24 @@ import (
 	"github.com/kubeshop/botkube/pkg/config"
 	"github.com/kubeshop/botkube/pkg/events"
 	"github.com/kubeshop/botkube/pkg/multierror"
+	"github.com/kubeshop/botkube/pkg/utils"
 )
 
 // Mattermost contains server URL and token
 type Mattermost struct {
 	log logrus.FieldLogger
 
-	Client       *model.Client4
-	Channel      string
+	Client *model.Client4
+	// Channels contains IDs of the configured channels which bind the sources
+	Channels     []string
 	Notification config.Notification
+
+	teamID string
+	// channelIDs caches IDs of the routed channels by their names
+	channelIDsMu sync.Mutex
+	channelIDs   map[string]string
+
+	threads *incidentThreads
 }
 
 // NewMattermost returns new Mattermost object
@@ -32,16 +44,33 @@ func NewMattermost(log logrus.FieldLogger, c config.Mattermost) (*Mattermost, er
 	if resp.Error != nil {
 		return nil, resp.Error
 	}
-	botChannel, resp := client.GetChannelByName(c.Channels.GetFirst().Name, botTeam.Id, "")
-	if resp.Error != nil {
-		return nil, resp.Error
+	var channelNames []string
+	for _, channel := range c.Channels {
+		if len(channel.Bindings.Sources) == 0 {
+			// channels which don't bind any source don't receive notifications
+			continue
+		}
+		channelNames = append(channelNames, channel.Name)
+	}
+	sort.Strings(channelNames)
+
+	var channels []string
+	for _, name := range channelNames {
+		botChannel, resp := client.GetChannelByName(name, botTeam.Id, "")
+		if resp.Error != nil {
+			return nil, resp.Error
+		}
+		channels = append(channels, botChannel.Id)
 	}
 
 	return &Mattermost{
 		log:          log,
 		Client:       client,
-		Channel:      botChannel.Id,
+		Channels:     channels,
 		Notification: c.Notification,
+		teamID:       botTeam.Id,
+		channelIDs:   map[string]string{},
+		threads:      newIncidentThreads(),
 	}, nil
 }
 
@@ -49,44 +78,58 @@ func NewMattermost(log logrus.FieldLogger, c config.Mattermost) (*Mattermost, er
 func (m *Mattermost) SendEvent(ctx context.Context, event events.Event) error {
 	m.log.Debugf(">> Sending to Mattermost: %+v", event)
 
-	var fields []*model.SlackAttachmentField
-
-	switch m.Notification.Type {
-	case config.LongNotification:
-		fields = mmLongNotification(event)
-	case config.ShortNotification:
-		fallthrough
-
-	default:
-		// set missing cluster name to event object
-		fields = mmShortNotification(event)
+	if event.Type == config.ResolvedEvent {
+		if refs := m.threads.Pop(event.IncidentKey); len(refs) > 0 {
+			return m.sendEventToThreads(event, refs)
+		}
 	}
 
-	attachment := []*model.SlackAttachment{
-		{
-			Color:     attachmentColor[event.Level],
-			Title:     event.Title,
-			Fields:    fields,
-			Footer:    "BotKube",
-			Timestamp: json.Number(strconv.FormatInt(event.TimeStamp.Unix(), 10)),
-		},
+	routedChannels := event.RoutedChannels.Mattermost
+	if len(routedChannels) == 0 && event.Channel != "" {
+		return m.sendEventToChannel(ctx, event, event.Channel)
+	}
+	if len(routedChannels) == 0 {
+		// events without the channel are sent to all channels which bind the sources
+		return m.sendEventToDefaultChannels(ctx, event)
 	}
 
-	targetChannel := event.Channel
-	if targetChannel == "" {
-		// empty value in event.channel sends notifications to default channel.
-		targetChannel = m.Channel
+	var errs error
+	for _, name := range routedChannels {
+		channelID, err := m.channelID(name)
+		if err != nil {
+			errs = multierror.Append(errs, err)
+			continue
+		}
+		if err := m.sendEventToChannel(ctx, event, channelID); err != nil {
+			errs = multierror.Append(errs, err)
+		}
 	}
-	isDefaultChannel := targetChannel == m.Channel
+	return errs
+}
+
+func (m *Mattermost) sendEventToChannel(ctx context.Context, event events.Event, targetChannel string) error {
+	attachment := formatMattermostAttachment(event, m.Notification)
+
+	isDefaultChannel := utils.Contains(m.Channels, targetChannel)
 
 	post := &model.Post{
 		Props: map[string]interface{}{
 			"attachments": attachment,
 		},
 		ChannelId: targetChannel,
+		Message:   messageText(mattermostMentions(event.Mentions[config.MattermostCommPlatformIntegration]), ackHint(event)),
+	}
+
+	if event.Manifest != "" {
+		// the event is sent even if the manifest cannot be uploaded
+		fileIDs, err := m.uploadManifest(targetChannel, event)
+		if err != nil {
+			m.log.Errorf("while uploading manifest to channel %q: %s", targetChannel, err.Error())
+		}
+		post.FileIds = fileIDs
 	}
 
-	_, resp := m.Client.CreatePost(post)
+	createdPost, resp := m.Client.CreatePost(post)
 	if resp.Error != nil {
 		createPostWrappedErr := fmt.Errorf("while posting message to channel %q: %w", targetChannel, resp.Error)
 
@@ -94,19 +137,17 @@ func (m *Mattermost) SendEvent(ctx context.Context, event events.Event) error {
 			return createPostWrappedErr
 		}
 
-		// fallback to default channel
+		// fallback to default channels
 
-		// send error message to default channel
+		// send error message to default channels
 		msg := fmt.Sprintf("Unable to send message to Channel `%s`: `%s`\n```add Botkube app to the Channel %s\nMissed events follows below:```", targetChannel, resp.Error, targetChannel)
 		sendMessageErr := m.SendMessage(ctx, msg)
 		if sendMessageErr != nil {
 			return multierror.Append(createPostWrappedErr, sendMessageErr)
 		}
 
-		// sending missed event to default channel
-		// reset event.Channel and send event
-		event.Channel = ""
-		sendEventErr := m.SendEvent(ctx, event)
+		// sending missed event to default channels
+		sendEventErr := m.sendEventToDefaultChannels(ctx, event)
 		if sendEventErr != nil {
 			return multierror.Append(createPostWrappedErr, sendEventErr)
 		}
@@ -115,20 +156,123 @@ func (m *Mattermost) SendEvent(ctx context.Context, event events.Event) error {
 	}
 
 	m.log.Debugf("Event successfully sent to channel %q", post.ChannelId)
+	if event.Type != config.ResolvedEvent {
+		m.threads.Add(event.IncidentKey, createdPost.ChannelId, createdPost.Id)
+	}
 	return nil
 }
 
-// SendMessage sends message to Mattermost channel
-func (m *Mattermost) SendMessage(_ context.Context, msg string) error {
-	post := &model.Post{
-		ChannelId: m.Channel,
-		Message:   msg,
+// sendEventToThreads sends the event as a reply in the threads of the posts about the incident.
+func (m *Mattermost) sendEventToThreads(event events.Event, refs []threadRef) error {
+	attachment := formatMattermostAttachment(event, m.Notification)
+
+	var errs error
+	for _, ref := range refs {
+		post := &model.Post{
+			Props: map[string]interface{}{
+				"attachments": attachment,
+			},
+			ChannelId: ref.ChannelID,
+			RootId:    ref.MessageID,
+		}
+		if _, resp := m.Client.CreatePost(post); resp.Error != nil {
+			errs = multierror.Append(errs, fmt.Errorf("while posting message in thread %q of channel %q: %w", ref.MessageID, ref.ChannelID, resp.Error))
+			continue
+		}
+		m.log.Debugf("Event successfully sent to thread %q of channel %q", ref.MessageID, ref.ChannelID)
+	}
+	return errs
+}
+
+func formatMattermostAttachment(event events.Event, notification config.Notification) []*model.SlackAttachment {
+	var fields []*model.SlackAttachmentField
+
+	switch notification.Type {
+	case config.LongNotification:
+		fields = mmLongNotification(event)
+	case config.ShortNotification:
+		fallthrough
+
+	default:
+		// set missing cluster name to event object
+		fields = mmShortNotification(event)
+	}
+
+	return []*model.SlackAttachment{
+		{
+			Color:     attachmentColor[event.Level],
+			Title:     event.Title,
+			Fields:    fields,
+			Footer:    "BotKube",
+			Timestamp: json.Number(strconv.FormatInt(event.TimeStamp.Unix(), 10)),
+		},
+	}
+}
+
+// mattermostMentions formats Mattermost usernames as mentions.
+func mattermostMentions(usernames []string) string {
+	var mentions []string
+	for _, username := range usernames {
+		mentions = append(mentions, "@"+strings.TrimPrefix(username, "@"))
 	}
-	if _, resp := m.Client.CreatePost(post); resp.Error != nil {
-		return fmt.Errorf("while creating a post: %w", resp.Error)
+	return strings.Join(mentions, " ")
+}
+
+// channelID returns ID of the channel with a given name.
+func (m *Mattermost) channelID(name string) (string, error) {
+	m.channelIDsMu.Lock()
+	defer m.channelIDsMu.Unlock()
+
+	if id, found := m.channelIDs[name]; found {
+		return id, nil
 	}
 
-	return nil
+	channel, resp := m.Client.GetChannelByName(name, m.teamID, "")
+	if resp.Error != nil {
+		return "", fmt.Errorf("while getting Mattermost channel %q: %w", name, resp.Error)
+	}
+	m.channelIDs[name] = channel.Id
+	return channel.Id, nil
+}
+
+// uploadManifest uploads the manifest attached to the event and returns IDs of uploaded files.
+func (m *Mattermost) uploadManifest(channelID string, event events.Event) ([]string, error) {
+	uploadResp, resp := m.Client.UploadFile([]byte(event.Manifest), channelID, manifestFileName(event))
+	if resp.Error != nil {
+		return nil, resp.Error
+	}
+
+	var fileIDs []string
+	for _, info := range uploadResp.FileInfos {
+		fileIDs = append(fileIDs, info.Id)
+	}
+	return fileIDs, nil
+}
+
+func (m *Mattermost) sendEventToDefaultChannels(ctx context.Context, event events.Event) error {
+	var errs error
+	for _, channelID := range m.Channels {
+		if err := m.sendEventToChannel(ctx, event, channelID); err != nil {
+			errs = multierror.Append(errs, err)
+		}
+	}
+	return errs
+}
+
+// SendMessage sends message to the configured Mattermost channels
+func (m *Mattermost) SendMessage(_ context.Context, msg string) error {
+	var errs error
+	for _, channelID := range m.Channels {
+		post := &model.Post{
+			ChannelId: channelID,
+			Message:   msg,
+		}
+		if _, resp := m.Client.CreatePost(post); resp.Error != nil {
+			errs = multierror.Append(errs, fmt.Errorf("while creating a post in channel %q: %w", channelID, resp.Error))
+		}
+	}
+
+	return errs
 }
 
 // IntegrationName describes the notifier integration name.
@@ -163,6 +307,14 @@ func mmLongNotification(event events.Event) []*model.SlackAttachmentField {
 		})
 	}
 
+	if event.Owner != nil {
+		fields = append(fields, &model.SlackAttachmentField{
+			Title: "Owner",
+			Value: event.Owner.String(),
+			Short: true,
+		})
+	}
+
 	if event.Reason != "" {
 		fields = append(fields, &model.SlackAttachmentField{
 			Title: "Reason",
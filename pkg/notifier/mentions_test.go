@@ -0,0 +1,49 @@
+package notifier
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMentions(t *testing.T) {
+	tests := []struct {
+		name     string
+		formatFn func([]string) string
+		given    []string
+		expected string
+	}{
+		{
+			name:     "Slack users and user groups",
+			formatFn: slackMentions,
+			given:    []string{"U0123ABC", "S0456DEF"},
+			expected: "<@U0123ABC> <!subteam^S0456DEF>",
+		},
+		{
+			name:     "Discord roles",
+			formatFn: discordMentions,
+			given:    []string{"987654321"},
+			expected: "<@&987654321>",
+		},
+		{
+			name:     "Mattermost users",
+			formatFn: mattermostMentions,
+			given:    []string{"john", "@jane"},
+			expected: "@john @jane",
+		},
+		{
+			name:     "No mentions",
+			formatFn: slackMentions,
+			expected: "",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// when
+			out := tc.formatFn(tc.given)
+
+			// then
+			assert.Equal(t, tc.expected, out)
+		})
+	}
+}
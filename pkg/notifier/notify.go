@@ -2,11 +2,15 @@ package notifier
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"os"
+	"strings"
 
 	"github.com/sirupsen/logrus"
 
 	"github.com/kubeshop/botkube/internal/analytics"
+	"github.com/kubeshop/botkube/pkg/audit"
 	"github.com/kubeshop/botkube/pkg/config"
 	"github.com/kubeshop/botkube/pkg/events"
 )
@@ -72,3 +76,70 @@ func LoadNotifiers(log logrus.FieldLogger, conf config.Communications, reporter
 
 	return notifiers, nil
 }
+
+// LoadAuditSinks returns the sinks of the audit records. The webhook and Elasticsearch sinks reuse the configured notifiers.
+func LoadAuditSinks(cfg config.Audit, notifiers []Notifier) ([]audit.Sink, error) {
+	var sinks []audit.Sink
+	if cfg.Stdout {
+		sinks = append(sinks, audit.NewJSONSink(os.Stdout))
+	}
+
+	var (
+		webhook *Webhook
+		els     *Elasticsearch
+	)
+	for _, n := range notifiers {
+		switch n := n.(type) {
+		case *Webhook:
+			webhook = n
+		case *Elasticsearch:
+			els = n
+		}
+	}
+
+	if cfg.Webhook {
+		if webhook == nil {
+			return nil, errors.New("webhook audit sink requires enabled webhook communication")
+		}
+		sinks = append(sinks, webhook)
+	}
+
+	if cfg.Elasticsearch.Enabled {
+		if els == nil {
+			return nil, errors.New("elasticsearch audit sink requires enabled elasticsearch communication")
+		}
+		sinks = append(sinks, &elasticsearchAuditSink{els: els, index: cfg.Elasticsearch.Index})
+	}
+
+	return sinks, nil
+}
+
+// manifestFileName returns the name of the file with the manifest attached to the event.
+func manifestFileName(event events.Event) string {
+	parts := []string{strings.ToLower(event.Kind)}
+	if event.Namespace != "" {
+		parts = append(parts, event.Namespace)
+	}
+	parts = append(parts, event.Name)
+	return strings.Join(parts, "-") + ".yaml"
+}
+
+// ackHint returns the hint how to acknowledge a given event, or empty string if the event is not tracked.
+func ackHint(event events.Event) string {
+	if event.AckID == "" {
+		return ""
+	}
+	return fmt.Sprintf("Reply `@BotKube ack %s` to acknowledge.", event.AckID)
+}
+
+// messageText joins the non-empty parts of the text sent together with the event attachment.
+func messageText(parts ...string) string {
+	var out []string
+	for _, part := range parts {
+		if part == "" {
+			continue
+		}
+		out = append(out, part)
+	}
+	return strings.Join(out, "\n")
+}
@@ -4,7 +4,9 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"sort"
 	"strconv"
+	"strings"
 
 	"github.com/sirupsen/logrus"
 	"github.com/slack-go/slack"
@@ -12,6 +14,7 @@ import (
 	"github.com/kubeshop/botkube/pkg/config"
 	"github.com/kubeshop/botkube/pkg/events"
 	"github.com/kubeshop/botkube/pkg/multierror"
+	"github.com/kubeshop/botkube/pkg/utils"
 )
 
 const sendFailureMessageFmt = "Unable to send message to Channel `%s`: `%s`\n```add Botkube app to the Channel %s\nMissed events follows below:```"
@@ -25,38 +28,77 @@ var attachmentColor = map[config.Level]string{
 	config.Critical: "danger",
 }
 
-// Slack contains Token for authentication with slack and Channel name to send notification to
+// Slack contains Token for authentication with slack and names of the Channels which bind the sources to send notification to
 type Slack struct {
 	log logrus.FieldLogger
 
-	Channel      string
+	Channels     []string
 	Notification config.Notification
 	Client       *slack.Client
+
+	threads *incidentThreads
 }
 
 // NewSlack returns new Slack object
 func NewSlack(log logrus.FieldLogger, c config.Slack) *Slack {
+	var channels []string
+	for _, channel := range c.Channels {
+		if len(channel.Bindings.Sources) == 0 {
+			// channels which don't bind any source don't receive notifications
+			continue
+		}
+		channels = append(channels, channel.Name)
+	}
+	sort.Strings(channels)
+
 	return &Slack{
 		log:          log,
-		Channel:      c.Channels.GetFirst().Name,
+		Channels:     channels,
 		Notification: c.Notification,
 		Client:       slack.New(c.Token),
+		threads:      newIncidentThreads(),
 	}
 }
 
 // SendEvent sends event notification to slack
 func (s *Slack) SendEvent(ctx context.Context, event events.Event) error {
 	s.log.Debugf(">> Sending to slack: %+v", event)
+
+	if event.Type == config.ResolvedEvent {
+		if refs := s.threads.Pop(event.IncidentKey); len(refs) > 0 {
+			return s.sendEventToThreads(ctx, event, refs)
+		}
+	}
+
+	routedChannels := event.RoutedChannels.Slack
+	if len(routedChannels) == 0 && event.Channel != "" {
+		return s.sendEventToChannel(ctx, event, event.Channel)
+	}
+	if len(routedChannels) == 0 {
+		// events without the channel are sent to all channels which bind the sources
+		routedChannels = s.Channels
+	}
+
+	var errs error
+	for _, channel := range routedChannels {
+		if err := s.sendEventToChannel(ctx, event, channel); err != nil {
+			errs = multierror.Append(errs, err)
+		}
+	}
+	return errs
+}
+
+func (s *Slack) sendEventToChannel(ctx context.Context, event events.Event, targetChannel string) error {
 	attachment := formatSlackMessage(event, s.Notification)
 
-	targetChannel := event.Channel
-	if targetChannel == "" {
-		// empty value in event.channel sends notifications to default channel.
-		targetChannel = s.Channel
+	isDefaultChannel := utils.Contains(s.Channels, targetChannel)
+
+	options := []slack.MsgOption{slack.MsgOptionAttachments(attachment), slack.MsgOptionAsUser(true)}
+	if text := messageText(slackMentions(event.Mentions[config.SlackCommPlatformIntegration]), ackHint(event)); text != "" {
+		options = append(options, slack.MsgOptionText(text, false))
 	}
-	isDefaultChannel := targetChannel == s.Channel
 
-	channelID, timestamp, err := s.Client.PostMessage(targetChannel, slack.MsgOptionAttachments(attachment), slack.MsgOptionAsUser(true))
+	channelID, timestamp, err := s.Client.PostMessage(targetChannel, options...)
 	if err != nil {
 		postMessageWrappedErr := fmt.Errorf("while posting message to channel %q: %w", targetChannel, err)
 
@@ -64,40 +106,95 @@ func (s *Slack) SendEvent(ctx context.Context, event events.Event) error {
 			return postMessageWrappedErr
 		}
 
-		// channel not found, fallback to default channel
+		// channel not found, fallback to default channels
 
-		// send error message to default channel
+		// send error message to default channels
 		msg := fmt.Sprintf(sendFailureMessageFmt, targetChannel, err.Error(), targetChannel)
 		sendMessageErr := s.SendMessage(ctx, msg)
 		if sendMessageErr != nil {
 			return multierror.Append(postMessageWrappedErr, sendMessageErr)
 		}
 
-		// sending missed event to default channel
-		// reset event.Channel and send event
-		event.Channel = ""
-		sendEventErr := s.SendEvent(ctx, event)
-		if sendEventErr != nil {
-			return multierror.Append(postMessageWrappedErr, sendEventErr)
+		// sending missed event to default channels
+		for _, channel := range s.Channels {
+			if sendEventErr := s.sendEventToChannel(ctx, event, channel); sendEventErr != nil {
+				postMessageWrappedErr = multierror.Append(postMessageWrappedErr, sendEventErr)
+			}
 		}
 
 		return postMessageWrappedErr
 	}
 
 	s.log.Debugf("Event successfully sent to channel %q at %s", channelID, timestamp)
+	if event.Type != config.ResolvedEvent {
+		s.threads.Add(event.IncidentKey, channelID, timestamp)
+	}
+
+	if event.Manifest != "" {
+		// the event was already sent, so the upload failure is only logged
+		if err := s.uploadManifest(ctx, channelID, timestamp, event); err != nil {
+			s.log.Errorf("while uploading manifest to channel %q: %s", channelID, err.Error())
+		}
+	}
 	return nil
 }
 
-// SendMessage sends message to slack channel
+// sendEventToThreads sends the event as a reply in the threads of the messages about the incident.
+func (s *Slack) sendEventToThreads(ctx context.Context, event events.Event, refs []threadRef) error {
+	attachment := formatSlackMessage(event, s.Notification)
+
+	var errs error
+	for _, ref := range refs {
+		_, _, err := s.Client.PostMessageContext(ctx, ref.ChannelID, slack.MsgOptionAttachments(attachment), slack.MsgOptionAsUser(true), slack.MsgOptionTS(ref.MessageID))
+		if err != nil {
+			errs = multierror.Append(errs, fmt.Errorf("while posting message in thread %q of channel %q: %w", ref.MessageID, ref.ChannelID, err))
+			continue
+		}
+		s.log.Debugf("Event successfully sent to thread %q of channel %q", ref.MessageID, ref.ChannelID)
+	}
+	return errs
+}
+
+// uploadManifest uploads the manifest attached to the event in the thread of the event message.
+func (s *Slack) uploadManifest(ctx context.Context, channelID, threadTimestamp string, event events.Event) error {
+	_, err := s.Client.UploadFileContext(ctx, slack.FileUploadParameters{
+		Content:         event.Manifest,
+		Filetype:        "yaml",
+		Filename:        manifestFileName(event),
+		Title:           manifestFileName(event),
+		Channels:        []string{channelID},
+		ThreadTimestamp: threadTimestamp,
+	})
+	return err
+}
+
+// SendMessage sends message to the configured slack channels
 func (s *Slack) SendMessage(ctx context.Context, msg string) error {
 	s.log.Debugf(">> Sending to slack: %+v", msg)
-	channelID, timestamp, err := s.Client.PostMessageContext(ctx, s.Channel, slack.MsgOptionText(msg, false), slack.MsgOptionAsUser(true))
-	if err != nil {
-		return fmt.Errorf("while sending Slack message to channel %q: %w", s.Channel, err)
+
+	var errs error
+	for _, channel := range s.Channels {
+		channelID, timestamp, err := s.Client.PostMessageContext(ctx, channel, slack.MsgOptionText(msg, false), slack.MsgOptionAsUser(true))
+		if err != nil {
+			errs = multierror.Append(errs, fmt.Errorf("while sending Slack message to channel %q: %w", channel, err))
+			continue
+		}
+		s.log.Debugf("Message successfully sent to channel %s at %s", channelID, timestamp)
 	}
+	return errs
+}
 
-	s.log.Debugf("Message successfully sent to channel %s at %s", channelID, timestamp)
-	return nil
+// slackMentions formats Slack user and user group IDs as mentions. User group IDs start with `S`.
+func slackMentions(ids []string) string {
+	var mentions []string
+	for _, id := range ids {
+		if strings.HasPrefix(id, "S") {
+			mentions = append(mentions, fmt.Sprintf("<!subteam^%s>", id))
+			continue
+		}
+		mentions = append(mentions, fmt.Sprintf("<@%s>", id))
+	}
+	return strings.Join(mentions, " ")
 }
 
 func formatSlackMessage(event events.Event, notification config.Notification) (attachment slack.Attachment) {
@@ -148,6 +245,14 @@ func slackLongNotification(event events.Event) slack.Attachment {
 		})
 	}
 
+	if event.Owner != nil {
+		attachment.Fields = append(attachment.Fields, slack.AttachmentField{
+			Title: "Owner",
+			Value: event.Owner.String(),
+			Short: true,
+		})
+	}
+
 	if event.Reason != "" {
 		attachment.Fields = append(attachment.Fields, slack.AttachmentField{
 			Title: "Reason",
@@ -296,6 +401,24 @@ func FormatShortMessage(event events.Event) (msg string) {
 				event.Cluster,
 			)
 		}
+	case config.ResolvedEvent:
+		switch event.Kind {
+		case "Namespace", "Node", "PersistentVolume", "ClusterRole", "ClusterRoleBinding":
+			msg = fmt.Sprintf(
+				"Resolved %s: *%s* in *%s* cluster\n",
+				event.Kind,
+				event.Name,
+				event.Cluster,
+			)
+		default:
+			msg = fmt.Sprintf(
+				"Resolved %s: *%s/%s* in *%s* cluster\n",
+				event.Kind,
+				event.Namespace,
+				event.Name,
+				event.Cluster,
+			)
+		}
 	case config.InfoEvent, config.NormalEvent:
 		switch event.Kind {
 		case "Namespace", "Node", "PersistentVolume", "ClusterRole", "ClusterRoleBinding":
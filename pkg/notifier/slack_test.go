@@ -0,0 +1,40 @@
+package notifier
+
+import (
+	"testing"
+
+	logtest "github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kubeshop/botkube/pkg/config"
+)
+
+func TestNewSlack_ChannelsBindingSources(t *testing.T) {
+	// given
+	logger, _ := logtest.NewNullLogger()
+	cfg := config.Slack{
+		Channels: config.IndexableMap[config.ChannelBindingsByName]{
+			"alerts": {
+				Name:     "alerts",
+				Bindings: config.BotBindings{Sources: []string{"k8s-events"}},
+			},
+			"ops": {
+				Name: "ops",
+				Bindings: config.BotBindings{
+					Sources:   []string{"k8s-events"},
+					Executors: []string{"kubectl-read-only"},
+				},
+			},
+			"commands-only": {
+				Name:     "commands-only",
+				Bindings: config.BotBindings{Executors: []string{"kubectl-read-only"}},
+			},
+		},
+	}
+
+	// when
+	slack := NewSlack(logger, cfg)
+
+	// then
+	assert.Equal(t, []string{"alerts", "ops"}, slack.Channels)
+}
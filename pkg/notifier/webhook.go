@@ -10,6 +10,7 @@ import (
 
 	"github.com/sirupsen/logrus"
 
+	"github.com/kubeshop/botkube/pkg/audit"
 	"github.com/kubeshop/botkube/pkg/config"
 	"github.com/kubeshop/botkube/pkg/events"
 	"github.com/kubeshop/botkube/pkg/multierror"
@@ -33,6 +34,12 @@ type WebhookPayload struct {
 	TimeStamp       time.Time   `json:"timestamp"`
 	Recommendations []string    `json:"recommendations,omitempty"`
 	Warnings        []string    `json:"warnings,omitempty"`
+	Manifest        string      `json:"manifest,omitempty"`
+}
+
+// WebhookAuditPayload contains json payload with the audit record of the executed command
+type WebhookAuditPayload struct {
+	Audit audit.Event `json:"audit"`
 }
 
 // EventMeta contains the meta data about the event occurred
@@ -40,7 +47,9 @@ type EventMeta struct {
 	Kind      string `json:"kind"`
 	Name      string `json:"name"`
 	Namespace string `json:"namespace"`
+	Owner     string `json:"owner,omitempty"`
 	Cluster   string `json:"cluster,omitempty"`
+	AckID     string `json:"ackId,omitempty"`
 }
 
 // EventStatus contains the status about the event occurred
@@ -75,7 +84,9 @@ func (w *Webhook) SendEvent(ctx context.Context, event events.Event) (err error)
 			Kind:      event.Kind,
 			Name:      event.Name,
 			Namespace: event.Namespace,
+			Owner:     webhookOwner(event.Owner),
 			Cluster:   event.Cluster,
+			AckID:     event.AckID,
 		},
 		EventStatus: EventStatus{
 			Type:     event.Type,
@@ -88,6 +99,7 @@ func (w *Webhook) SendEvent(ctx context.Context, event events.Event) (err error)
 		TimeStamp:       event.TimeStamp,
 		Recommendations: event.Recommendations,
 		Warnings:        event.Warnings,
+		Manifest:        event.Manifest,
 	}
 
 	err = w.PostWebhook(ctx, jsonPayload)
@@ -104,8 +116,21 @@ func (w *Webhook) SendMessage(_ context.Context, _ string) error {
 	return nil
 }
 
+// SendAuditEvent sends the audit record of the executed command to Webhook url
+func (w *Webhook) SendAuditEvent(ctx context.Context, event audit.Event) error {
+	err := w.postJSON(ctx, &WebhookAuditPayload{Audit: event})
+	if err != nil {
+		return fmt.Errorf("while sending audit record to webhook: %w", err)
+	}
+	return nil
+}
+
 // PostWebhook posts webhook to listener
-func (w *Webhook) PostWebhook(ctx context.Context, jsonPayload *WebhookPayload) (err error) {
+func (w *Webhook) PostWebhook(ctx context.Context, jsonPayload *WebhookPayload) error {
+	return w.postJSON(ctx, jsonPayload)
+}
+
+func (w *Webhook) postJSON(ctx context.Context, jsonPayload interface{}) (err error) {
 	message, err := json.Marshal(jsonPayload)
 	if err != nil {
 		return err
@@ -145,3 +170,10 @@ func (w *Webhook) IntegrationName() config.CommPlatformIntegration {
 func (w *Webhook) Type() config.IntegrationType {
 	return config.SinkIntegrationType
 }
+
+func webhookOwner(owner *events.Owner) string {
+	if owner == nil {
+		return ""
+	}
+	return owner.String()
+}
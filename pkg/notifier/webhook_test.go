@@ -2,12 +2,21 @@ package notifier
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
+	logtest "github.com/sirupsen/logrus/hooks/test"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kubeshop/botkube/pkg/audit"
+	"github.com/kubeshop/botkube/pkg/config"
+	"github.com/kubeshop/botkube/pkg/events"
 )
 
 // Unit test PostWebhook
@@ -44,3 +53,65 @@ func TestPostWebhook(t *testing.T) {
 		})
 	}
 }
+
+func TestWebhook_SendEvent_WithManifest(t *testing.T) {
+	// given
+	var payload WebhookPayload
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&payload))
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer ts.Close()
+
+	logger, _ := logtest.NewNullLogger()
+	w := &Webhook{log: logger, URL: ts.URL}
+
+	event := events.Event{
+		TypeMeta:  metav1.TypeMeta{Kind: "ConfigMap", APIVersion: "v1"},
+		Name:      "app-config",
+		Namespace: "default",
+		Type:      config.DeleteEvent,
+		Level:     config.Critical,
+		Manifest:  "apiVersion: v1\nkind: ConfigMap\n",
+	}
+
+	// when
+	err := w.SendEvent(context.Background(), event)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, event.Manifest, payload.Manifest)
+	assert.Equal(t, "configmap-default-app-config.yaml", manifestFileName(event))
+}
+
+func TestWebhook_SendAuditEvent(t *testing.T) {
+	// given
+	var payload WebhookAuditPayload
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&payload))
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer ts.Close()
+
+	logger, _ := logtest.NewNullLogger()
+	w := &Webhook{log: logger, URL: ts.URL}
+
+	event := audit.Event{
+		Timestamp:  time.Date(2022, 7, 1, 12, 0, 0, 0, time.UTC),
+		Cluster:    "prod",
+		Platform:   config.SlackCommPlatformIntegration,
+		Channel:    "C-OPS",
+		User:       "U-DEV",
+		Command:    "get pods",
+		Allowed:    true,
+		Status:     audit.StatusSucceeded,
+		DurationMs: 120,
+	}
+
+	// when
+	err := w.SendAuditEvent(context.Background(), event)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, event, payload.Audit)
+}
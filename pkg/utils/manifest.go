@@ -0,0 +1,68 @@
+package utils
+
+import (
+	"bytes"
+	"fmt"
+
+	"gopkg.in/yaml.v3"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+const (
+	lastAppliedConfigAnnotation = "kubectl.kubernetes.io/last-applied-configuration"
+	redactedValue               = "<redacted>"
+)
+
+// metadataFieldsSetByCluster contains metadata fields which are set by the cluster and shouldn't be restored.
+var metadataFieldsSetByCluster = []string{
+	"managedFields",
+	"resourceVersion",
+	"uid",
+	"selfLink",
+	"generation",
+	"creationTimestamp",
+	"deletionTimestamp",
+	"deletionGracePeriodSeconds",
+}
+
+// CleanManifest returns the YAML manifest of the object without the status and fields set by the cluster,
+// so it can be used to restore the object. Values of the Secret data are redacted.
+func CleanManifest(obj *unstructured.Unstructured) (string, error) {
+	cleaned := obj.DeepCopy()
+
+	unstructured.RemoveNestedField(cleaned.Object, "status")
+	for _, field := range metadataFieldsSetByCluster {
+		unstructured.RemoveNestedField(cleaned.Object, "metadata", field)
+	}
+	unstructured.RemoveNestedField(cleaned.Object, "metadata", "annotations", lastAppliedConfigAnnotation)
+	if len(cleaned.GetAnnotations()) == 0 {
+		unstructured.RemoveNestedField(cleaned.Object, "metadata", "annotations")
+	}
+
+	if cleaned.GetKind() == "Secret" {
+		for _, field := range []string{"data", "stringData"} {
+			data, found, err := unstructured.NestedMap(cleaned.Object, field)
+			if err != nil || !found {
+				continue
+			}
+			for key := range data {
+				data[key] = redactedValue
+			}
+			if err := unstructured.SetNestedMap(cleaned.Object, data, field); err != nil {
+				return "", fmt.Errorf("while redacting Secret %s: %w", field, err)
+			}
+		}
+	}
+
+	var buff bytes.Buffer
+	enc := yaml.NewEncoder(&buff)
+	enc.SetIndent(2)
+	if err := enc.Encode(cleaned.Object); err != nil {
+		return "", fmt.Errorf("while encoding manifest: %w", err)
+	}
+	if err := enc.Close(); err != nil {
+		return "", fmt.Errorf("while encoding manifest: %w", err)
+	}
+
+	return buff.String(), nil
+}
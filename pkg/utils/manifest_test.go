@@ -0,0 +1,101 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestCleanManifest(t *testing.T) {
+	tests := []struct {
+		name     string
+		given    map[string]interface{}
+		expected string
+	}{
+		{
+			name: "ConfigMap",
+			given: map[string]interface{}{
+				"apiVersion": "v1",
+				"kind":       "ConfigMap",
+				"metadata": map[string]interface{}{
+					"name":              "app-config",
+					"namespace":         "default",
+					"uid":               "ff68560b-44e8-4b0d-880b-e114f5d15933",
+					"resourceVersion":   "1234",
+					"creationTimestamp": "2022-07-01T12:00:00Z",
+					"managedFields":     []interface{}{map[string]interface{}{"manager": "kubectl"}},
+					"annotations": map[string]interface{}{
+						lastAppliedConfigAnnotation: `{"apiVersion":"v1"}`,
+					},
+					"labels": map[string]interface{}{"app": "web"},
+				},
+				"data": map[string]interface{}{"LOG_LEVEL": "debug"},
+			},
+			expected: heredoc.Doc(`
+				apiVersion: v1
+				data:
+				  LOG_LEVEL: debug
+				kind: ConfigMap
+				metadata:
+				  labels:
+				    app: web
+				  name: app-config
+				  namespace: default
+			`),
+		},
+		{
+			name: "Secret with redacted data",
+			given: map[string]interface{}{
+				"apiVersion": "v1",
+				"kind":       "Secret",
+				"metadata":   map[string]interface{}{"name": "creds", "namespace": "default"},
+				"type":       "Opaque",
+				"data":       map[string]interface{}{"password": "c2VjcmV0"},
+			},
+			expected: heredoc.Doc(`
+				apiVersion: v1
+				data:
+				  password: <redacted>
+				kind: Secret
+				metadata:
+				  name: creds
+				  namespace: default
+				type: Opaque
+			`),
+		},
+		{
+			name: "Deployment without status",
+			given: map[string]interface{}{
+				"apiVersion": "apps/v1",
+				"kind":       "Deployment",
+				"metadata":   map[string]interface{}{"name": "web", "generation": int64(3)},
+				"spec":       map[string]interface{}{"replicas": int64(2)},
+				"status":     map[string]interface{}{"readyReplicas": int64(2)},
+			},
+			expected: heredoc.Doc(`
+				apiVersion: apps/v1
+				kind: Deployment
+				metadata:
+				  name: web
+				spec:
+				  replicas: 2
+			`),
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			obj := &unstructured.Unstructured{Object: tc.given}
+
+			// when
+			manifest, err := CleanManifest(obj)
+
+			// then
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, manifest)
+		})
+	}
+}
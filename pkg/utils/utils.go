@@ -162,3 +162,17 @@ func RemoveHyperlink(hyperlink string) string {
 	}
 	return command
 }
+
+// NamespaceMatches checks if the namespace matches a given name or pattern with the * wildcard.
+func NamespaceMatches(pattern, namespace string) bool {
+	if pattern == namespace {
+		return true
+	}
+	if !strings.Contains(pattern, "*") {
+		return false
+	}
+
+	expr := fmt.Sprintf("^%s$", strings.Replace(regexp.QuoteMeta(pattern), `\*`, ".*", -1))
+	matched, err := regexp.MatchString(expr, namespace)
+	return err == nil && matched
+}
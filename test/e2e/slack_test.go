@@ -125,7 +125,6 @@ func TestSlack(t *testing.T) {
 			  - auth
 			  - cluster-info
 			  - describe
-			  - diff
 			  - explain
 			  - get
 			  - logs
@@ -192,7 +191,7 @@ func TestSlack(t *testing.T) {
 
 		t.Run("Get forbidden resource", func(t *testing.T) {
 			command := "get ingress"
-			expectedMessage := codeBlock("Command not supported. Please run /botkubehelp to see supported commands.")
+			expectedMessage := codeBlock("Command not supported. Please run `@BotKube help` to see supported commands.")
 
 			slackTester.PostMessageToBot(t, channel.Name, command)
 			err = slackTester.WaitForLastMessageEqual(botUserID, channel.ID, expectedMessage)
@@ -201,7 +200,7 @@ func TestSlack(t *testing.T) {
 
 		t.Run("Specify unknown command", func(t *testing.T) {
 			command := "unknown"
-			expectedMessage := codeBlock("Command not supported. Please run /botkubehelp to see supported commands.")
+			expectedMessage := codeBlock("Command not supported. Please run `@BotKube help` to see supported commands.")
 
 			slackTester.PostMessageToBot(t, channel.Name, command)
 			err = slackTester.WaitForLastMessageEqual(botUserID, channel.ID, expectedMessage)
@@ -210,7 +209,7 @@ func TestSlack(t *testing.T) {
 
 		t.Run("Specify invalid command", func(t *testing.T) {
 			command := "get"
-			expectedMessage := codeBlock("Command not supported. Please run /botkubehelp to see supported commands.")
+			expectedMessage := codeBlock("Command not supported. Please run `@BotKube help` to see supported commands.")
 
 			slackTester.PostMessageToBot(t, channel.Name, command)
 			err = slackTester.WaitForLastMessageEqual(botUserID, channel.ID, expectedMessage)